@@ -27,7 +27,9 @@ import (
 
 	itool "github.com/ServiceWeaver/weaver/internal/tool"
 	"github.com/ServiceWeaver/weaver/internal/tool/callgraph"
+	"github.com/ServiceWeaver/weaver/internal/tool/compat"
 	"github.com/ServiceWeaver/weaver/internal/tool/generate"
+	"github.com/ServiceWeaver/weaver/internal/tool/inspect"
 	"github.com/ServiceWeaver/weaver/internal/tool/multi"
 	"github.com/ServiceWeaver/weaver/internal/tool/single"
 	"github.com/ServiceWeaver/weaver/internal/tool/ssh"
@@ -38,6 +40,8 @@ const usage = `USAGE
 
   weaver generate                 // weaver code generator
   weaver version                  // show weaver version
+  weaver inspect   <binary>       // describe an application binary
+  weaver compat    <old> <new>    // check compatibility of two binaries
   weaver single    <command> ...  // for single process deployments
   weaver multi     <command> ...  // for multiprocess deployments
   weaver ssh       <command> ...  // for multimachine deployments
@@ -49,9 +53,10 @@ DESCRIPTION
 
   Use the "weaver" command to deploy and manage Weaver applications.
 
-  The "weaver generate", "weaver version", "weaver single", "weaver multi", and
-  "weaver ssh" subcommands are baked in, but all other subcommands of the form
-  "weaver <deployer>" dispatch to a binary called "weaver-<deployer>".
+  The "weaver generate", "weaver version", "weaver inspect", "weaver compat",
+  "weaver single", "weaver multi", and "weaver ssh" subcommands are baked in,
+  but all other subcommands of the form "weaver <deployer>" dispatch to a
+  binary called "weaver-<deployer>".
   "weaver gke status", for example, dispatches to "weaver-gke status".
 `
 
@@ -77,8 +82,9 @@ func main() {
 		generateFlags.Usage = func() {
 			fmt.Fprintln(os.Stderr, generate.Usage)
 		}
+		mocks := generateFlags.Bool("mocks", false, "Generate mocks for component interfaces")
 		generateFlags.Parse(flag.Args()[1:])
-		if err := generate.Generate(".", flag.Args()[1:], generate.Options{}); err != nil {
+		if err := generate.Generate(".", generateFlags.Args(), generate.Options{Mocks: *mocks}); err != nil {
 			fmt.Fprintln(os.Stderr, err)
 			os.Exit(1)
 		}
@@ -120,6 +126,45 @@ Description:
 		fmt.Println(s)
 		return
 
+	case "inspect":
+		flags := flag.NewFlagSet("inspect", flag.ExitOnError)
+		format := flags.String("format", "text", "Output format: text, json, or dot.")
+		flags.Usage = func() { fmt.Fprintln(os.Stderr, inspect.Usage) }
+		flags.Parse(flag.Args()[1:])
+		if flags.NArg() != 1 {
+			fmt.Fprintln(os.Stderr, "ERROR: want one binary.")
+			os.Exit(1)
+		}
+		info, err := inspect.Inspect(flags.Arg(0))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if err := info.Write(os.Stdout, *format); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+
+	case "compat":
+		flags := flag.NewFlagSet("compat", flag.ExitOnError)
+		flags.Usage = func() { fmt.Fprintln(os.Stderr, compat.Usage) }
+		flags.Parse(flag.Args()[1:])
+		if flags.NArg() != 2 {
+			fmt.Fprintln(os.Stderr, "ERROR: want two binaries.")
+			os.Exit(1)
+		}
+		report, err := compat.Compare(flags.Arg(0), flags.Arg(1))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		report.Write(os.Stdout)
+		if !report.Safe() {
+			os.Exit(1)
+		}
+		return
+
 	case "single", "multi", "ssh":
 		os.Args = os.Args[1:]
 		tool.Run("weaver "+flag.Arg(0), internals[flag.Arg(0)])
@@ -135,6 +180,12 @@ Description:
 		case n == 2 && command == "generate":
 			// weaver help generate
 			fmt.Fprintln(os.Stdout, generate.Usage)
+		case n == 2 && command == "inspect":
+			// weaver help inspect
+			fmt.Fprintln(os.Stdout, inspect.Usage)
+		case n == 2 && command == "compat":
+			// weaver help compat
+			fmt.Fprintln(os.Stdout, compat.Usage)
 		case n == 2 && internals[command] != nil:
 			// weaver help <command>
 			fmt.Fprintln(os.Stdout, tool.MainHelp("weaver "+command, internals[command]))
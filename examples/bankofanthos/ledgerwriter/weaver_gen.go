@@ -31,7 +31,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return t_reflect_stub{caller: caller}
 		},
-		RefData: "⟦7237a6f4:wEaVeReDgE:github.com/ServiceWeaver/weaver/examples/bankofanthos/ledgerwriter/T→github.com/ServiceWeaver/weaver/examples/bankofanthos/balancereader/T⟧\n",
+		RefData: "⟦7237a6f4:wEaVeReDgE:github.com/ServiceWeaver/weaver/examples/bankofanthos/ledgerwriter/T→github.com/ServiceWeaver/weaver/examples/bankofanthos/balancereader/T⟧\n⟦96fc84a2:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/examples/bankofanthos/ledgerwriter/T→;AddTransaction=d066d808⟧\n",
 	})
 }
 
@@ -59,7 +59,7 @@ func (s t_local_stub) AddTransaction(ctx context.Context, a0 string, a1 string,
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "ledgerwriter.T.AddTransaction", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "ledgerwriter.T.AddTransaction", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/bankofanthos/ledgerwriter/T")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -91,7 +91,7 @@ func (s t_client_stub) AddTransaction(ctx context.Context, a0 string, a1 string,
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "ledgerwriter.T.AddTransaction", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "ledgerwriter.T.AddTransaction", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/bankofanthos/ledgerwriter/T")))
 	}
 
 	defer func() {
@@ -137,10 +137,10 @@ func (s t_client_stub) AddTransaction(ctx context.Context, a0 string, a1 string,
 // Note that "weaver generate" will always generate the error message below.
 // Everything is okay. The error message is only relevant if you see it when
 // you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][20]struct{}](`
+var _ codegen.LatestVersion = codegen.Version[[0][23]struct{}](`
 
 ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.20.0). The generated code is incompatible with the version of the
+version v0.23.0). The generated code is incompatible with the version of the
 github.com/ServiceWeaver/weaver module that you're using. The weaver module
 version can be found in your go.mod file or by running the following command.
 
@@ -31,7 +31,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return t_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦daebb979:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/examples/bankofanthos/transactionhistory/T→;GetTransactions=e9acc782⟧\n",
 	})
 }
 
@@ -59,7 +59,7 @@ func (s t_local_stub) GetTransactions(ctx context.Context, a0 string) (r0 []mode
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "transactionhistory.T.GetTransactions", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "transactionhistory.T.GetTransactions", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/bankofanthos/transactionhistory/T")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -91,7 +91,7 @@ func (s t_client_stub) GetTransactions(ctx context.Context, a0 string) (r0 []mod
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "transactionhistory.T.GetTransactions", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "transactionhistory.T.GetTransactions", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/bankofanthos/transactionhistory/T")))
 	}
 
 	defer func() {
@@ -141,10 +141,10 @@ func (s t_client_stub) GetTransactions(ctx context.Context, a0 string) (r0 []mod
 // Note that "weaver generate" will always generate the error message below.
 // Everything is okay. The error message is only relevant if you see it when
 // you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][20]struct{}](`
+var _ codegen.LatestVersion = codegen.Version[[0][23]struct{}](`
 
 ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.20.0). The generated code is incompatible with the version of the
+version v0.23.0). The generated code is incompatible with the version of the
 github.com/ServiceWeaver/weaver module that you're using. The weaver module
 version can be found in your go.mod file or by running the following command.
 
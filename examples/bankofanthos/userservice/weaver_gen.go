@@ -31,7 +31,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return t_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦dfd2c23f:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T→;CreateUser=65e60a7e,Login=3797da17⟧\n",
 	})
 }
 
@@ -60,7 +60,7 @@ func (s t_local_stub) CreateUser(ctx context.Context, a0 CreateUserRequest) (err
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "userservice.T.CreateUser", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "userservice.T.CreateUser", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -80,7 +80,7 @@ func (s t_local_stub) Login(ctx context.Context, a0 LoginRequest) (r0 string, er
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "userservice.T.Login", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "userservice.T.Login", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -113,7 +113,7 @@ func (s t_client_stub) CreateUser(ctx context.Context, a0 CreateUserRequest) (er
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "userservice.T.CreateUser", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "userservice.T.CreateUser", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T")))
 	}
 
 	defer func() {
@@ -168,7 +168,7 @@ func (s t_client_stub) Login(ctx context.Context, a0 LoginRequest) (r0 string, e
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "userservice.T.Login", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "userservice.T.Login", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/bankofanthos/userservice/T")))
 	}
 
 	defer func() {
@@ -218,10 +218,10 @@ func (s t_client_stub) Login(ctx context.Context, a0 LoginRequest) (r0 string, e
 // Note that "weaver generate" will always generate the error message below.
 // Everything is okay. The error message is only relevant if you see it when
 // you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][20]struct{}](`
+var _ codegen.LatestVersion = codegen.Version[[0][23]struct{}](`
 
 ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.20.0). The generated code is incompatible with the version of the
+version v0.23.0). The generated code is incompatible with the version of the
 github.com/ServiceWeaver/weaver module that you're using. The weaver module
 version can be found in your go.mod file or by running the following command.
 
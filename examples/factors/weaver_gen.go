@@ -15,10 +15,12 @@ import (
 
 func init() {
 	codegen.Register(codegen.Registration{
-		Name:   "github.com/ServiceWeaver/weaver/examples/factors/Factorer",
-		Iface:  reflect.TypeOf((*Factorer)(nil)).Elem(),
-		Impl:   reflect.TypeOf(factorer{}),
-		Routed: true,
+		Name:       "github.com/ServiceWeaver/weaver/examples/factors/Factorer",
+		Iface:      reflect.TypeOf((*Factorer)(nil)).Elem(),
+		Impl:       reflect.TypeOf(factorer{}),
+		Routed:     true,
+		RoutingKey: reflect.TypeOf((*int)(nil)).Elem(),
+		ShardKeyFn: func(key any) uint64 { return _hashFactorer(key.(int)) },
 		LocalStubFn: func(impl any, caller string, tracer trace.Tracer) any {
 			return factorer_local_stub{impl: impl.(Factorer), tracer: tracer, factorsMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/examples/factors/Factorer", Method: "Factors", Remote: false})}
 		},
@@ -31,7 +33,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return factorer_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦56102f11:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/examples/factors/Factorer→4a7e67fc;Factors=c7709f1d⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:      "github.com/ServiceWeaver/weaver/Main",
@@ -48,7 +50,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return main_reflect_stub{caller: caller}
 		},
-		RefData: "⟦4724da9b:wEaVeReDgE:github.com/ServiceWeaver/weaver/Main→github.com/ServiceWeaver/weaver/examples/factors/Factorer⟧\n⟦68699208:wEaVeRlIsTeNeRs:github.com/ServiceWeaver/weaver/Main→factors⟧\n",
+		RefData: "⟦4724da9b:wEaVeReDgE:github.com/ServiceWeaver/weaver/Main→github.com/ServiceWeaver/weaver/examples/factors/Factorer⟧\n⟦68699208:wEaVeRlIsTeNeRs:github.com/ServiceWeaver/weaver/Main→factors⟧\n⟦5389bccb:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/Main→;⟧\n",
 	})
 }
 
@@ -81,7 +83,7 @@ func (s factorer_local_stub) Factors(ctx context.Context, a0 int) (r0 []int, err
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "main.Factorer.Factors", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "main.Factorer.Factors", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/factors/Factorer")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -121,7 +123,7 @@ func (s factorer_client_stub) Factors(ctx context.Context, a0 int) (r0 []int, er
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "main.Factorer.Factors", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "main.Factorer.Factors", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/examples/factors/Factorer")))
 	}
 
 	defer func() {
@@ -181,10 +183,10 @@ var _ weaver.Main = (*main_client_stub)(nil)
 // Note that "weaver generate" will always generate the error message below.
 // Everything is okay. The error message is only relevant if you see it when
 // you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][20]struct{}](`
+var _ codegen.LatestVersion = codegen.Version[[0][23]struct{}](`
 
 ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.20.0). The generated code is incompatible with the version of the
+version v0.23.0). The generated code is incompatible with the version of the
 github.com/ServiceWeaver/weaver module that you're using. The weaver module
 version can be found in your go.mod file or by running the following command.
 
@@ -15,6 +15,7 @@
 package weaver
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net"
@@ -33,6 +34,8 @@ func init() {
 	weaver.FillListeners = fillListeners
 	weaver.HasConfig = hasConfig
 	weaver.GetConfig = getConfig
+	weaver.SetLeadership = setLeadership
+	weaver.NotifyOwnership = notifyOwnership
 }
 
 // See internal/weaver/types.go.
@@ -45,6 +48,37 @@ func setLogger(v any, logger *slog.Logger) error {
 	return nil
 }
 
+// See internal/weaver/types.go.
+func setLeadership(v any, leadership *weaver.Leadership) error {
+	x, ok := v.(interface{ setLeadership(*weaver.Leadership) })
+	if !ok {
+		return fmt.Errorf("SetLeadership: %T does not embed weaver.Singleton", v)
+	}
+	x.setLeadership(leadership)
+	return nil
+}
+
+// See internal/weaver/types.go.
+func notifyOwnership(ctx context.Context, impl any, change weaver.OwnershipChange) {
+	x, ok := impl.(OwnershipObserver)
+	if !ok {
+		return
+	}
+	convert := func(ranges []weaver.KeyRange) []KeyRange {
+		converted := make([]KeyRange, len(ranges))
+		for i, r := range ranges {
+			converted[i] = KeyRange(r)
+		}
+		return converted
+	}
+	x.OwnershipChanged(ctx, OwnershipChange{
+		Version:  change.Version,
+		Acquired: convert(change.Acquired),
+		Released: convert(change.Released),
+		shardKey: change.ShardKey,
+	})
+}
+
 // See internal/weaver/types.go.
 func hasRefs(impl any) bool {
 	p := reflect.ValueOf(impl)
@@ -15,14 +15,13 @@
 package weaver
 
 import (
-	"math/rand"
 	"net/http"
-	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/ServiceWeaver/weaver/metrics"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // TODO(mwhittaker): Measure the size of HTTP requests.
@@ -68,7 +67,10 @@ var (
 
 // InstrumentHandler instruments the provided HTTP handler to collect sampled
 // traces and metrics of HTTP request executions. Each trace and metric is
-// labelled with the supplied label. The following metrics are collected:
+// labelled with the supplied label, and traces record the request path.
+// Traces are sampled according to the trace_sampling policy in the application
+// config; by default, at most one trace per second is sampled for every
+// listener. The following metrics are collected:
 //
 //   - serviceweaver_http_request_count: Total number of requests.
 //   - serviceweaver_http_error_count: Total number of 4XX and 5XX replies.
@@ -85,6 +87,9 @@ func InstrumentHandler(label string, handler http.Handler) http.Handler {
 		// hostname).
 		labels := httpLabels{Label: label, Host: r.Host}
 
+		// Record the request path, so that traces can be searched by route.
+		trace.SpanFromContext(r.Context()).SetAttributes(semconv.HTTPTargetKey.String(r.URL.Path))
+
 		httpRequestCounts.Get(labels).Add(1)
 		defer func() {
 			httpRequestLatencyMicros.Get(labels).Put(
@@ -104,12 +109,7 @@ func InstrumentHandler(label string, handler http.Handler) http.Handler {
 		}
 		httpRequestBytesReturned.Get(labels).Put(float64(writer.responseSize(r)))
 	})
-	const traceSampleInterval = 1 * time.Second
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
-	s := newTraceSampler(traceSampleInterval, rng)
-	return otelhttp.NewHandler(h, label, otelhttp.WithFilter(func(r *http.Request) bool {
-		return s.shouldTrace(time.Now())
-	}))
+	return otelhttp.NewHandler(h, label)
 }
 
 // InstrumentHandlerFunc is identical to [InstrumentHandler] but takes a
@@ -118,48 +118,6 @@ func InstrumentHandlerFunc(label string, f func(http.ResponseWriter, *http.Reque
 	return InstrumentHandler(label, http.HandlerFunc(f))
 }
 
-// traceSampler is a time-based request sampler for tracing.
-//
-// It allows at most one request to be traced during each time interval.
-type traceSampler struct {
-	intervalNs float64
-
-	mu               sync.Mutex
-	rng              *rand.Rand
-	nextSampleTimeNs atomic.Int64
-}
-
-// newTraceSampler returns a new traceSampler that allows at most one request
-// to be traced during each time interval.
-func newTraceSampler(interval time.Duration, rng *rand.Rand) *traceSampler {
-	return &traceSampler{
-		intervalNs: float64(interval / time.Nanosecond),
-		rng:        rng,
-	}
-}
-
-// shouldTrace returns true iff a request should be traced.
-func (s *traceSampler) shouldTrace(now time.Time) bool {
-	// Fast-path check avoids acquiring a mutex or modifying the sampler in any
-	// way.
-	nowNs := now.UnixNano()
-	if nowNs < s.nextSampleTimeNs.Load() {
-		return false
-	}
-
-	// Check again while holding the lock.
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if nowNs < s.nextSampleTimeNs.Load() {
-		return false
-	}
-
-	// Pick a new value for nextSampleTime and return.
-	// We pick a random value in [0,s*s.intervalNs] (averages out to s.intervalNs).
-	s.nextSampleTimeNs.Store(nowNs + int64(2*s.rng.Float64()*s.intervalNs))
-	return true
-}
-
 // responseWriterInstrumenter is a wrapper around an http.ResponseWriter that
 // records the status code of the response.
 type responseWriterInstrumenter struct {
@@ -15,11 +15,7 @@
 package weaver
 
 import (
-	"math"
-	"math/rand"
 	"net/http"
-	"testing"
-	"time"
 )
 
 func ExampleInstrumentHandler() {
@@ -28,32 +24,3 @@ func ExampleInstrumentHandler() {
 	mux.Handle("/bar", InstrumentHandler("bar", http.HandlerFunc(func(http.ResponseWriter, *http.Request) { /*...*/ })))
 	http.ListenAndServe(":9000", &mux)
 }
-
-type fixedRandSource int64
-
-var _ rand.Source = fixedRandSource(0)
-
-func (s fixedRandSource) Int63() int64 { return int64(s) }
-func (s fixedRandSource) Seed(int64)   {}
-
-func TestTraceSampler(t *testing.T) {
-	// We run with an interval of a 1s and an "rng" that always returns 0.5, so
-	// each sampling gap should be 1s.
-	rng := rand.New(fixedRandSource(math.MaxInt64 / 2))
-	s := newTraceSampler(time.Second, rng)
-	now := time.Now()
-	const numTimeIntervals = 100
-	var numTraces int
-	for i := 0; i < numTimeIntervals; i++ {
-		now = now.Add(time.Second)
-		for j := 0; j < 10; j++ {
-			if s.shouldTrace(now.Add(time.Millisecond)) {
-				numTraces++
-			}
-		}
-	}
-	if numTraces != numTimeIntervals {
-		t.Fatalf("unexpected number of traces sampled: want %d, got %d", numTimeIntervals, numTraces)
-	}
-
-}
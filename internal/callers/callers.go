@@ -0,0 +1,21 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package callers defines caller names reserved for Service Weaver itself.
+package callers
+
+// Uninstrumented is the caller passed to a client stub that is used only to
+// encode arguments and decode results, and not to make a real call. Method
+// metrics are not recorded for calls made by such client stubs.
+const Uninstrumented = "weaver.uninstrumented"
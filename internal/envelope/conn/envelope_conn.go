@@ -304,6 +304,18 @@ func (e *EnvelopeConn) GetProfileRPC(req *protos.GetProfileRequest) ([]byte, err
 	return reply.GetProfileReply.Data, nil
 }
 
+// CallComponentRPC calls a component method on the weavelet.
+func (e *EnvelopeConn) CallComponentRPC(req *protos.CallComponentRequest) (*protos.CallComponentReply, error) {
+	reply, err := e.rpc(&protos.EnvelopeMsg{CallComponentRequest: req})
+	if err != nil {
+		return nil, err
+	}
+	if reply.CallComponentReply == nil {
+		return nil, fmt.Errorf("nil CallComponentReply received from weavelet")
+	}
+	return reply.CallComponentReply, nil
+}
+
 // UpdateComponentsRPC updates the weavelet with the latest set of components
 // it should be running.
 func (e *EnvelopeConn) UpdateComponentsRPC(components []string) error {
@@ -340,6 +352,38 @@ func (e *EnvelopeConn) UpdateRoutingInfoRPC(routing *protos.RoutingInfo) error {
 	return nil
 }
 
+// UpdateLeadershipRPC updates whether the weavelet's replica of the provided
+// singleton component is the component's leader.
+func (e *EnvelopeConn) UpdateLeadershipRPC(component string, leader bool) error {
+	req := &protos.EnvelopeMsg{
+		UpdateLeadershipRequest: &protos.UpdateLeadershipRequest{
+			Component: component,
+			Leader:    leader,
+		},
+	}
+	reply, err := e.rpc(req)
+	if err != nil {
+		return err
+	}
+	if reply.UpdateLeadershipReply == nil {
+		return fmt.Errorf("nil UpdateLeadershipReply received from weavelet")
+	}
+	return nil
+}
+
+// SetLogLevelRPC changes the minimum log level of a component hosted by the
+// weavelet.
+func (e *EnvelopeConn) SetLogLevelRPC(req *protos.SetLogLevelRequest) error {
+	reply, err := e.rpc(&protos.EnvelopeMsg{SetLogLevelRequest: req})
+	if err != nil {
+		return err
+	}
+	if reply.SetLogLevelReply == nil {
+		return fmt.Errorf("nil SetLogLevelReply received from weavelet")
+	}
+	return nil
+}
+
 func (e *EnvelopeConn) rpc(request *protos.EnvelopeMsg) (*protos.WeaveletMsg, error) {
 	response, err := e.conn.doBlockingRPC(request)
 	if err != nil {
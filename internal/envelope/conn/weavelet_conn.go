@@ -45,6 +45,16 @@ type WeaveletHandler interface {
 
 	// UpdateRoutingInfo updates a component's routing information.
 	UpdateRoutingInfo(*protos.UpdateRoutingInfoRequest) (*protos.UpdateRoutingInfoReply, error)
+
+	// UpdateLeadership updates whether the weavelet's replica of a singleton
+	// component is the component's leader.
+	UpdateLeadership(*protos.UpdateLeadershipRequest) (*protos.UpdateLeadershipReply, error)
+
+	// SetLogLevel changes the minimum log level of a component.
+	SetLogLevel(*protos.SetLogLevelRequest) (*protos.SetLogLevelReply, error)
+
+	// CallComponent calls a component method.
+	CallComponent(*protos.CallComponentRequest) (*protos.CallComponentReply, error)
 }
 
 // WeaveletConn is the weavelet side of the connection between a weavelet and
@@ -199,6 +209,20 @@ func (w *WeaveletConn) handleMessage(handler WeaveletHandler, msg *protos.Envelo
 			})
 		}()
 		return nil
+	case msg.CallComponentRequest != nil:
+		// A call may block for a long time, so we process it in a separate
+		// goroutine, like profiling requests.
+		id := msg.Id
+		req := protomsg.Clone(msg.CallComponentRequest)
+		go func() {
+			reply, err := handler.CallComponent(req)
+			w.conn.send(&protos.WeaveletMsg{
+				Id:                 -id,
+				Error:              errstring(err),
+				CallComponentReply: reply,
+			})
+		}()
+		return nil
 	case msg.UpdateComponentsRequest != nil:
 		reply, err := handler.UpdateComponents(msg.UpdateComponentsRequest)
 		return w.conn.send(&protos.WeaveletMsg{
@@ -213,6 +237,20 @@ func (w *WeaveletConn) handleMessage(handler WeaveletHandler, msg *protos.Envelo
 			Error:                  errstring(err),
 			UpdateRoutingInfoReply: reply,
 		})
+	case msg.UpdateLeadershipRequest != nil:
+		reply, err := handler.UpdateLeadership(msg.UpdateLeadershipRequest)
+		return w.conn.send(&protos.WeaveletMsg{
+			Id:                    -msg.Id,
+			Error:                 errstring(err),
+			UpdateLeadershipReply: reply,
+		})
+	case msg.SetLogLevelRequest != nil:
+		reply, err := handler.SetLogLevel(msg.SetLogLevelRequest)
+		return w.conn.send(&protos.WeaveletMsg{
+			Id:               -msg.Id,
+			Error:            errstring(err),
+			SetLogLevelReply: reply,
+		})
 	default:
 		err := fmt.Errorf("weavelet_conn: unexpected message %+v", msg)
 		w.conn.cleanup(err)
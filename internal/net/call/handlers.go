@@ -43,8 +43,9 @@ type Handler func(ctx context.Context, args []byte) ([]byte, error)
 // HandlerMap is a mapping from MethodID to a Handler. The zero value for a
 // HandlerMap is an empty map.
 type HandlerMap struct {
-	handlers map[MethodKey]Handler
-	names    map[MethodKey]string
+	handlers   map[MethodKey]Handler
+	names      map[MethodKey]string
+	components map[MethodKey]string
 }
 
 // NewHandlerMap returns a handler map to which the server handlers can
@@ -52,8 +53,9 @@ type HandlerMap struct {
 // returned map.
 func NewHandlerMap() *HandlerMap {
 	hm := &HandlerMap{
-		handlers: map[MethodKey]Handler{},
-		names:    map[MethodKey]string{},
+		handlers:   map[MethodKey]Handler{},
+		names:      map[MethodKey]string{},
+		components: map[MethodKey]string{},
 	}
 	// Add a dummy "ready" handler. Clients will repeatedly call this
 	// RPC until it responds successfully, ensuring the server is ready.
@@ -68,6 +70,7 @@ func (hm *HandlerMap) Set(component, method string, handler Handler) {
 	fp := MakeMethodKey(component, method)
 	hm.handlers[fp] = handler
 	hm.names[fp] = component + "." + method
+	hm.components[fp] = component
 }
 
 // AddHandlers adds handlers for all methods of the component with the
@@ -65,6 +65,10 @@ type hyperparameters struct {
 	NumOps      int     // the number of ops to run
 	FailureRate float64 // the fraction of calls to artificially fail
 	YieldRate   float64 // the probability that an op yields after a step
+
+	// If Data is not nil, the executor draws its randomness from Data rather
+	// than from Seed. See byteSource for details.
+	Data []byte
 }
 
 // generator is an untyped Generator[T].
@@ -102,8 +106,12 @@ type executor struct {
 	ctx   context.Context // execution context
 	group *errgroup.Group // group with all running goroutines
 
+	seeded *rand.Rand  // random number generator seeded by params.Seed
+	fuzzed *rand.Rand  // random number generator driven by params.Data
+	bytes  *byteSource // the source of fuzzed
+
 	mu          sync.Mutex       // guards the following fields
-	rand        *rand.Rand       // random number generator
+	rand        *rand.Rand       // random number generator, seeded or fuzzed
 	current     int              // currently running op
 	numStarted  int              // number of started ops
 	notFinished ints             // not finished op trace ids, optimized for removal and sampling
@@ -197,6 +205,8 @@ func newExecutor(t testing.TB, w reflect.Type, regsByIntf map[reflect.Type]*code
 	for intf := range regsByIntf {
 		registered[intf] = struct{}{}
 	}
+	bytes := &byteSource{}
+	seeded := rand.New(&wyrand{0})
 	return &executor{
 		w:          w,
 		regsByIntf: regsByIntf,
@@ -204,7 +214,10 @@ func newExecutor(t testing.TB, w reflect.Type, regsByIntf map[reflect.Type]*code
 		config:     app,
 		registrar:  newRegistrar(t, w, registered),
 		components: make(map[string][]any, len(regsByIntf)),
-		rand:       rand.New(&wyrand{0}),
+		seeded:     seeded,
+		fuzzed:     rand.New(bytes),
+		bytes:      bytes,
+		rand:       seeded,
 		calls:      map[int][]*call{},
 		replies:    map[int][]*reply{},
 	}
@@ -272,7 +285,13 @@ func (e *executor) reset(workload Workload, fakes map[reflect.Type]any, ops []*o
 	e.workload = reflect.ValueOf(workload)
 	e.params = params
 	e.ops = ops
-	e.rand.Seed(params.Seed)
+	if params.Data != nil {
+		e.bytes.reset(params.Data)
+		e.rand = e.fuzzed
+	} else {
+		e.rand = e.seeded
+		e.rand.Seed(params.Seed)
+	}
 	e.current = 1
 	e.numStarted = 0
 	e.notFinished.reset(1, 1+params.NumOps)
@@ -326,6 +345,14 @@ func (e *executor) reset(workload Workload, fakes map[reflect.Type]any, ops []*o
 				return err
 			}
 
+			// Set leadership. The first replica of a singleton component is
+			// its leader.
+			if reg.Singleton {
+				if err := weaver.SetLeadership(obj, weaver.NewLeadership(i == 0)); err != nil {
+					return err
+				}
+			}
+
 			// Fill ref fields.
 			if e.info.hasRefs[reg.Iface] {
 				if err := weaver.FillRefs(obj, func(t reflect.Type) (any, error) {
@@ -681,7 +708,12 @@ func (e *executor) deliverCall(call *call) (err error) {
 	component = reg.Name
 	replicas := e.components[component]
 	e.mu.Lock()
-	index = e.rand.Intn(len(replicas))
+	if reg.Singleton {
+		// Calls to a singleton component are delivered to its leader.
+		index = 0
+	} else {
+		index = e.rand.Intn(len(replicas))
+	}
 	replica := replicas[index]
 
 	// Record a DeliverCall event.
@@ -0,0 +1,118 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sim
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+// # Coverage-Guided Simulation
+//
+// Simulator.Run explores executions by sweeping over hyperparameters and
+// random seeds. Simulator.Fuzz instead lets Go's coverage-guided fuzzer [1]
+// pick the executions. Every fuzz input consists of four bytes that determine
+// the hyperparameters of an execution (the number of ops, the number of
+// replicas, the failure rate, and the yield rate) and a slice of bytes from
+// which the executor draws all of its randomness (see byteSource). This means
+// the fuzzer directly controls which ops are run, the arguments they are run
+// with, how ops are interleaved, and which calls fail.
+//
+// When the fuzzer finds a failing input, it writes the input to the fuzz
+// corpus in testdata/fuzz/FuzzFoo, where it is re-executed by every future
+// "go test" invocation. Simulator.Fuzz also re-executes the simulator
+// graveyard in testdata/sim/FuzzFoo, so a workload can be both simulated and
+// fuzzed without losing previously found failures.
+//
+// [1]: https://go.dev/security/fuzz
+
+// fuzzMaxOps is the maximum number of ops in a fuzzed execution.
+const fuzzMaxOps = 32
+
+// Fuzz runs a coverage-guided simulation of the workload using Go's native
+// fuzzing. The Simulator must have been created with the provided testing.F.
+// For example:
+//
+//	func FuzzMyWorkload(f *testing.F) {
+//	    s := sim.New(f, &myWorkload{}, sim.Options{})
+//	    s.Fuzz(f)
+//	}
+//
+// Running "go test" executes the graveyard and the fuzz corpus. Running "go
+// test -fuzz=FuzzMyWorkload" fuzzes the workload until a failing execution is
+// found.
+func (s *Simulator) Fuzz(f *testing.F) {
+	f.Helper()
+
+	// Execute the graveyard entries.
+	r, err := s.executeGraveyard(context.Background(), &stats{})
+	if err != nil {
+		f.Fatalf("Simulator.Fuzz: %v", err)
+	}
+	if r.err != nil {
+		results := Results{Err: r.err, History: r.history, NumExecutions: 1, NumOps: r.params.NumOps}
+		f.Fatalf("Simulator.Fuzz: graveyard entry failed: %v\n\n%s", r.err, results.Mermaid())
+	}
+
+	// Seed the corpus with a small sweep over hyperparameters.
+	for _, numOps := range []uint8{1, 2, 4} {
+		for _, numReplicas := range []uint8{0, 1, 2} {
+			for _, failureRate := range []uint8{0, 13} {
+				for _, yieldRate := range []uint8{0, 128} {
+					f.Add(numOps-1, numReplicas, failureRate, yieldRate, []byte{})
+				}
+			}
+		}
+	}
+
+	// The fuzzing engine may invoke the fuzz function from multiple
+	// goroutines, but an executor is not safe for concurrent use.
+	var mu sync.Mutex
+	exec := s.newExecutor()
+	f.Fuzz(func(t *testing.T, numOps, numReplicas, failureRate, yieldRate uint8, data []byte) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		// The registrar reports errors to the test, but f must not be used
+		// inside the fuzz target.
+		exec.registrar.t = t
+
+		p := fuzzHyperparameters(numOps, numReplicas, failureRate, yieldRate, data)
+		r, err := exec.execute(context.Background(), p)
+		if err != nil {
+			t.Fatalf("Simulator.Fuzz: %v", err)
+		}
+		if r.err != nil {
+			results := Results{Err: r.err, History: r.history, NumExecutions: 1, NumOps: p.NumOps}
+			t.Fatalf("Simulator.Fuzz: %v\n\n%s", r.err, results.Mermaid())
+		}
+	})
+}
+
+// fuzzHyperparameters converts fuzz input into valid hyperparameters.
+func fuzzHyperparameters(numOps, numReplicas, failureRate, yieldRate uint8, data []byte) hyperparameters {
+	if data == nil {
+		// A nil Data instructs the executor to use Seed.
+		data = []byte{}
+	}
+	return hyperparameters{
+		NumOps:      1 + int(numOps)%fuzzMaxOps,
+		NumReplicas: 1 + int(numReplicas)%3,
+		FailureRate: float64(failureRate) / 255,
+		YieldRate:   float64(yieldRate) / 255,
+		Data:        data,
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sim
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func FuzzPassingSimulation(f *testing.F) {
+	s := New(f, &divModWorkload{}, Options{})
+	s.Fuzz(f)
+}
+
+func TestFuzzedExecutionDeterministic(t *testing.T) {
+	// Execute the same fuzz input twice, and check that the executions are
+	// identical.
+	s := New(t, &divModWorkload{}, Options{})
+	data := []byte("a fuzzer would pick these bytes at random")
+	p := fuzzHyperparameters(10, 2, 25, 128, data)
+	var histories [][]Event
+	for i := 0; i < 2; i++ {
+		r, err := s.newExecutor().execute(context.Background(), p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		histories = append(histories, r.history)
+	}
+	if diff := cmp.Diff(histories[0], histories[1]); diff != "" {
+		t.Fatalf("histories differ (-first +second):\n%s", diff)
+	}
+}
+
+func TestFuzzedExecutionFindsFailure(t *testing.T) {
+	// Drive divideByZeroWorkload with all zero bytes. Every generator picks
+	// its smallest value, so the very first op divides by zero.
+	s := New(t, &divideByZeroWorkload{}, Options{})
+	data := make([]byte, 1024)
+	r, err := s.newExecutor().execute(context.Background(), fuzzHyperparameters(0, 0, 0, 0, data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.err == nil {
+		t.Fatal("unexpected success")
+	}
+}
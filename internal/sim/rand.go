@@ -15,6 +15,7 @@
 package sim
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math/bits"
 	"math/rand"
@@ -47,7 +48,12 @@ func flip(r *rand.Rand, p float64) bool {
 	if p < 0 || p > 1 {
 		panic(fmt.Errorf("flip: probability %f not in range [0, 1.0]", p))
 	}
-	return r.Float64() <= p
+	// Always draw a value, even when p is 0, so that the stream of random
+	// values, and therefore the rest of the execution, doesn't depend on p.
+	// Fuzz input frequently makes r.Float64() return exactly 0, so p == 0 is
+	// special-cased for flip(0) to always return false.
+	f := r.Float64()
+	return p > 0 && f <= p
 }
 
 // ints represents a remove-only set of integers in the range [low, high).
@@ -155,3 +161,45 @@ func wymix(x uint64, y uint64) uint64 {
 	hi, lo := bits.Mul64(x, y)
 	return hi ^ lo
 }
+
+// byteSource is a source of randomness that draws its values from a fixed
+// slice of bytes. byteSource is used to drive a simulation from fuzz input,
+// letting Go's coverage-guided fuzzer pick the random choices made by an
+// executor. Every value consumes the next eight bytes of input. Once the
+// input is exhausted, byteSource falls back to a wyrand with a fixed seed, so
+// that every input, including the empty input, yields a complete execution.
+type byteSource struct {
+	data     []byte
+	fallback wyrand
+}
+
+var _ rand.Source = &byteSource{}
+var _ rand.Source64 = &byteSource{}
+
+// reset resets a byteSource to draw randomness from the provided bytes.
+func (b *byteSource) reset(data []byte) {
+	b.data = data
+	b.fallback.seed = 0
+}
+
+// Seed implements the rand.Source interface. Seed only affects the values
+// returned after the input bytes are exhausted.
+func (b *byteSource) Seed(seed int64) {
+	b.fallback.Seed(seed)
+}
+
+// Int63 implements the rand.Source interface.
+func (b *byteSource) Int63() int64 {
+	return int64(b.Uint64() >> 1)
+}
+
+// Uint64 implements the rand.Source64 interface.
+func (b *byteSource) Uint64() uint64 {
+	if len(b.data) == 0 {
+		return b.fallback.Uint64()
+	}
+	var buf [8]byte
+	n := copy(buf[:], b.data)
+	b.data = b.data[n:]
+	return binary.BigEndian.Uint64(buf[:])
+}
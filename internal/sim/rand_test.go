@@ -128,3 +128,22 @@ func TestWyRandDeterministic(t *testing.T) {
 		}
 	}
 }
+
+func TestByteSource(t *testing.T) {
+	var b byteSource
+	b.reset([]byte{0, 0, 0, 0, 0, 0, 0, 42, 1})
+	if got, want := b.Uint64(), uint64(42); got != want {
+		t.Errorf("Uint64: got %d, want %d", got, want)
+	}
+	// A partial read is padded with zeros.
+	if got, want := b.Uint64(), uint64(1)<<56; got != want {
+		t.Errorf("Uint64: got %d, want %d", got, want)
+	}
+	// Once exhausted, the source falls back to a wyrand with seed 0.
+	w := wyrand{0}
+	for i := 0; i < 10; i++ {
+		if got, want := b.Uint64(), w.Uint64(); got != want {
+			t.Errorf("Uint64: got %d, want %d", got, want)
+		}
+	}
+}
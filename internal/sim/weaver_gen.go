@@ -31,7 +31,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return blocker_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦d3b1faea:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/sim/blocker→;Block=c5db102e⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:  "github.com/ServiceWeaver/weaver/internal/sim/div",
@@ -49,7 +49,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return div_reflect_stub{caller: caller}
 		},
-		RefData: "⟦972edc6b:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/sim/div→github.com/ServiceWeaver/weaver/internal/sim/identity⟧\n",
+		RefData: "⟦972edc6b:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/sim/div→github.com/ServiceWeaver/weaver/internal/sim/identity⟧\n⟦a14234af:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/sim/div→;Div=c8bd07fb⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:  "github.com/ServiceWeaver/weaver/internal/sim/divMod",
@@ -67,7 +67,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return divMod_reflect_stub{caller: caller}
 		},
-		RefData: "⟦1fbf09ec:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/sim/divMod→github.com/ServiceWeaver/weaver/internal/sim/div⟧\n⟦c2f088a8:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/sim/divMod→github.com/ServiceWeaver/weaver/internal/sim/mod⟧\n",
+		RefData: "⟦1fbf09ec:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/sim/divMod→github.com/ServiceWeaver/weaver/internal/sim/div⟧\n⟦c2f088a8:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/sim/divMod→github.com/ServiceWeaver/weaver/internal/sim/mod⟧\n⟦e8824551:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/sim/divMod→;DivMod=068df262⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:  "github.com/ServiceWeaver/weaver/internal/sim/identity",
@@ -85,7 +85,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return identity_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦bf557ac9:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/sim/identity→;Identity=d94d815b⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:  "github.com/ServiceWeaver/weaver/internal/sim/mod",
@@ -103,7 +103,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return mod_reflect_stub{caller: caller}
 		},
-		RefData: "⟦1dff5ab5:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/sim/mod→github.com/ServiceWeaver/weaver/internal/sim/identity⟧\n",
+		RefData: "⟦1dff5ab5:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/sim/mod→github.com/ServiceWeaver/weaver/internal/sim/identity⟧\n⟦f15ea254:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/sim/mod→;Mod=c8bd07fb⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:  "github.com/ServiceWeaver/weaver/internal/sim/panicker",
@@ -121,7 +121,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return panicker_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦fdfa14c9:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/sim/panicker→;Panic=6de09adb⟧\n",
 	})
 }
 
@@ -159,7 +159,7 @@ func (s blocker_local_stub) Block(ctx context.Context) (err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "sim.blocker.Block", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "sim.blocker.Block", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/blocker")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -188,7 +188,7 @@ func (s div_local_stub) Div(ctx context.Context, a0 int, a1 int) (r0 int, err er
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "sim.div.Div", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "sim.div.Div", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/div")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -217,7 +217,7 @@ func (s divMod_local_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int,
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "sim.divMod.DivMod", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "sim.divMod.DivMod", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/divMod")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -246,7 +246,7 @@ func (s identity_local_stub) Identity(ctx context.Context, a0 int) (r0 int, err
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "sim.identity.Identity", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "sim.identity.Identity", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/identity")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -275,7 +275,7 @@ func (s mod_local_stub) Mod(ctx context.Context, a0 int, a1 int) (r0 int, err er
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "sim.mod.Mod", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "sim.mod.Mod", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/mod")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -304,7 +304,7 @@ func (s panicker_local_stub) Panic(ctx context.Context, a0 bool) (err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "sim.panicker.Panic", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "sim.panicker.Panic", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/panicker")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -336,7 +336,7 @@ func (s blocker_client_stub) Block(ctx context.Context) (err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "sim.blocker.Block", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "sim.blocker.Block", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/blocker")))
 	}
 
 	defer func() {
@@ -390,7 +390,7 @@ func (s div_client_stub) Div(ctx context.Context, a0 int, a1 int) (r0 int, err e
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "sim.div.Div", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "sim.div.Div", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/div")))
 	}
 
 	defer func() {
@@ -456,7 +456,7 @@ func (s divMod_client_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int,
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "sim.divMod.DivMod", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "sim.divMod.DivMod", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/divMod")))
 	}
 
 	defer func() {
@@ -523,7 +523,7 @@ func (s identity_client_stub) Identity(ctx context.Context, a0 int) (r0 int, err
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "sim.identity.Identity", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "sim.identity.Identity", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/identity")))
 	}
 
 	defer func() {
@@ -587,7 +587,7 @@ func (s mod_client_stub) Mod(ctx context.Context, a0 int, a1 int) (r0 int, err e
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "sim.mod.Mod", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "sim.mod.Mod", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/mod")))
 	}
 
 	defer func() {
@@ -653,7 +653,7 @@ func (s panicker_client_stub) Panic(ctx context.Context, a0 bool) (err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "sim.panicker.Panic", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "sim.panicker.Panic", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/sim/panicker")))
 	}
 
 	defer func() {
@@ -702,10 +702,10 @@ func (s panicker_client_stub) Panic(ctx context.Context, a0 bool) (err error) {
 // Note that "weaver generate" will always generate the error message below.
 // Everything is okay. The error message is only relevant if you see it when
 // you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][20]struct{}](`
+var _ codegen.LatestVersion = codegen.Version[[0][23]struct{}](`
 
 ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.20.0). The generated code is incompatible with the version of the
+version v0.23.0). The generated code is incompatible with the version of the
 github.com/ServiceWeaver/weaver module that you're using. The weaver module
 version can be found in your go.mod file or by running the following command.
 
@@ -0,0 +1,59 @@
+/**
+ * Copyright 2023 Google LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+'use strict';
+
+// The code below largely taken from:
+//   https://perfetto.dev/docs/visualization/deep-linking-to-perfetto-ui
+const ORIGIN = 'https://ui.perfetto.dev';
+
+// fetchAndOpen fetches the trace with the provided id and opens it in the
+// Perfetto UI.
+async function fetchAndOpen(traceId) {
+  const traceUrl = '/tracefetch?trace_id=' + traceId;
+  const resp = await fetch(traceUrl);
+  const blob = await resp.blob();
+  const arrayBuffer = await blob.arrayBuffer();
+  openTrace(arrayBuffer, traceId, traceUrl);
+}
+
+// openTrace opens the provided trace in the Perfetto UI.
+function openTrace(arrayBuffer, traceId, traceUrl) {
+  const win = window.open(ORIGIN);
+  if (!win) {
+    alert('Popups blocked. Please allow popups in order to be able to' +
+          'see traces');
+    return
+  }
+  const timer = setInterval(() => win.postMessage('PING', ORIGIN), 50);
+  const onMessageHandler = (evt) => {
+    if (evt.data !== 'PONG') return;
+
+    // We got a PONG, the UI is ready.
+    window.clearInterval(timer);
+    window.removeEventListener('message', onMessageHandler);
+
+    const reopenUrl = new URL(location.href);
+    reopenUrl.hash = `#reopen=${traceUrl}`;
+    win.postMessage({
+      perfetto: {
+        buffer: arrayBuffer,
+        title: 'Trace Id ' + traceId,
+        url: reopenUrl.toString(),
+    }}, ORIGIN);
+  };
+
+  window.addEventListener('message', onMessageHandler);
+}
@@ -0,0 +1,208 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/logging"
+	protos "github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/tool"
+)
+
+var (
+	callFlags      = flag.NewFlagSet("call", flag.ContinueOnError)
+	callRoutingKey = callFlags.String("routing_key", "", "JSON encoded routing key of a routed component")
+	callTimeout    = callFlags.Duration("timeout", time.Minute, "Cancel the call after this duration")
+)
+
+// CallCommand returns a "call" subcommand that calls a component method.
+func CallCommand(toolName string, registry func(context.Context) (*Registry, error)) *tool.Command {
+	const help = `Usage:
+  {{.Tool}} call [options] <deployment> <component>.<method> [<args>]
+
+Flags:
+  -h, --help	Print this help message.
+{{.Flags}}
+
+Description:
+  '{{.Tool}} call <deployment> <component>.<method> <args>' calls a method
+  of a component in a running deployment and prints the method's results.
+  <deployment> is the id of the deployment which can be found using
+  '{{.Tool}} status' or '{{.Tool}} dashboard'. Like '{{.Tool}} profile',
+  '{{.Tool}} call' accepts a uniquely identifying prefix of a deployment id.
+
+  <component> is the full name of the component (e.g.,
+  github.com/example/reverser/Reverser) or any suffix of it that starts
+  after a slash (e.g., reverser/Reverser). <args> is a JSON array of the
+  method's arguments, excluding the context. It can be omitted if the method
+  doesn't take any arguments. The method's results, excluding the error, are
+  printed as a JSON array.
+
+  The call is made by the application, as if the application itself called
+  the method. Calls to routed components are routed based on their arguments,
+  unless a JSON encoded routing key is provided with --routing_key. The call
+  is cancelled if it doesn't finish within --timeout.
+
+Examples:
+  # Call the Reverse method of the reverser/Reverser component.
+  {{.Tool}} call 2c80d811 reverser/Reverser.Reverse '["hello"]'
+
+  # Call the Get method of a routed cache/Cache component, routing the call
+  # with the routing key "foo".
+  {{.Tool}} call --routing_key='"foo"' 2c80d811 cache/Cache.Get '["foo"]'`
+	var b strings.Builder
+	t := template.Must(template.New(toolName).Parse(help))
+	content := struct{ Tool, Flags string }{toolName, tool.FlagsHelp(callFlags)}
+	if err := t.Execute(&b, content); err != nil {
+		panic(err)
+	}
+
+	return &tool.Command{
+		Name:        "call",
+		Description: "Call a component method of a running Service Weaver application",
+		Help:        b.String(),
+		Flags:       callFlags,
+		Fn: func(ctx context.Context, args []string) error {
+			// Validate command line arguments.
+			usage := fmt.Errorf("usage: %s call [options] <deployment> <component>.<method> [<args>]", toolName)
+			if len(args) != 2 && len(args) != 3 {
+				return usage
+			}
+			prefix, target := args[0], args[1]
+			if prefix == "" {
+				return usage
+			}
+			i := strings.LastIndex(target, ".")
+			if i <= 0 || i == len(target)-1 {
+				return fmt.Errorf("invalid method %q; want <component>.<method>", target)
+			}
+			component, method := target[:i], target[i+1:]
+			callArgs := "[]"
+			if len(args) == 3 {
+				callArgs = args[2]
+			}
+			if !json.Valid([]byte(callArgs)) {
+				return fmt.Errorf("invalid arguments %q; want a JSON array", callArgs)
+			}
+			if *callRoutingKey != "" && !json.Valid([]byte(*callRoutingKey)) {
+				return fmt.Errorf("invalid routing key %q; want a JSON value", *callRoutingKey)
+			}
+			if *callTimeout <= 0 {
+				return fmt.Errorf("invalid --timeout %v: must be positive", *callTimeout)
+			}
+
+			// Get the corresponding deployment.
+			reg, err := findDeployment(ctx, registry, prefix)
+			if err != nil {
+				return err
+			}
+			client := NewAuthorizedClient(reg.Addr, reg.Token)
+
+			// Get the full component name.
+			status, err := client.Status(ctx)
+			if err != nil {
+				return err
+			}
+			component, err = resolveComponent(status, component)
+			if err != nil {
+				return err
+			}
+
+			// Call the method.
+			reply, err := client.CallComponent(ctx, &protos.CallComponentRequest{
+				Component:  component,
+				Method:     method,
+				Args:       callArgs,
+				RoutingKey: *callRoutingKey,
+				TimeoutNs:  callTimeout.Nanoseconds(),
+			})
+			if err != nil {
+				return err
+			}
+			var out bytes.Buffer
+			if err := json.Indent(&out, []byte(reply.Results), "", "  "); err != nil {
+				return fmt.Errorf("invalid results %q: %w", reply.Results, err)
+			}
+			fmt.Println(out.String())
+			return nil
+		},
+	}
+}
+
+// findDeployment returns the registration of the deployment whose id has the
+// provided prefix. It returns an error if there isn't exactly one such
+// deployment.
+func findDeployment(ctx context.Context, registry func(context.Context) (*Registry, error), prefix string) (Registration, error) {
+	r, err := registry(ctx)
+	if err != nil {
+		return Registration{}, fmt.Errorf("create registry: %w", err)
+	}
+	regs, err := r.List(ctx)
+	if err != nil {
+		return Registration{}, fmt.Errorf("get registrations: %w", err)
+	}
+	var candidates []Registration
+	for _, reg := range regs {
+		if strings.HasPrefix(reg.DeploymentId, prefix) {
+			candidates = append(candidates, reg)
+		}
+	}
+	if len(candidates) == 0 {
+		return Registration{}, fmt.Errorf("no deployment with prefix %q found", prefix)
+	}
+	if len(candidates) > 1 {
+		fmt.Fprintf(os.Stderr, "The deployment id prefix %q is ambiguous. Expand the prefix to identify one of the following deployments:\n", prefix)
+		for _, candidate := range candidates {
+			fmt.Fprintf(os.Stderr, "  - %s\n", candidate.DeploymentId)
+		}
+		return Registration{}, fmt.Errorf("multiple deployments with prefix %q found", prefix)
+	}
+	return candidates[0], nil
+}
+
+// resolveComponent returns the full name of the component in the provided
+// status identified by name. name is either a full component name, a suffix
+// of a full component name that starts after a slash, or a shortened
+// component name (see logging.ShortenComponent). Components that are not
+// running are not listed in the status, so if no component matches, name is
+// assumed to be a full component name.
+func resolveComponent(status *Status, name string) (string, error) {
+	var candidates []string
+	for _, c := range status.Components {
+		if c.Name == name {
+			return name, nil
+		}
+		if strings.HasSuffix(c.Name, "/"+name) || logging.ShortenComponent(c.Name) == name {
+			candidates = append(candidates, c.Name)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return name, nil
+	case 1:
+		return candidates[0], nil
+	default:
+		return "", fmt.Errorf("component %q is ambiguous; it matches %s", name, strings.Join(candidates, ", "))
+	}
+}
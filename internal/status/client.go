@@ -25,21 +25,30 @@ import (
 // Client is an HTTP client to a status server. It's assumed the status server
 // registered itself with RegisterServer.
 type Client struct {
-	addr string // status server (e.g., "localhost:12345")
+	addr   string       // status server (e.g., "localhost:12345")
+	client *http.Client // HTTP client used to issue requests
 }
 
 var _ Server = &Client{}
 
 // NewClient returns a client to the status server on the provided address.
 func NewClient(addr string) *Client {
-	return &Client{addr}
+	return &Client{addr: addr, client: http.DefaultClient}
+}
+
+// NewAuthorizedClient returns a client to the status server on the provided
+// address that attaches the provided deployment token to every request. Only
+// an authorized client can call CallComponent and SetLogLevel.
+func NewAuthorizedClient(addr, token string) *Client {
+	transport := tokenTransport{token: token, base: http.DefaultTransport}
+	return &Client{addr: addr, client: &http.Client{Transport: transport}}
 }
 
 // Status implements the Server interface.
 func (c *Client) Status(ctx context.Context) (*Status, error) {
 	status := &Status{}
 	err := protomsg.Call(ctx, protomsg.CallArgs{
-		Client:  http.DefaultClient,
+		Client:  c.client,
 		Addr:    "http://" + c.addr,
 		URLPath: statusEndpoint,
 		Reply:   status,
@@ -55,7 +64,7 @@ func (c *Client) Status(ctx context.Context) (*Status, error) {
 func (c *Client) Metrics(ctx context.Context) (*Metrics, error) {
 	metrics := &Metrics{}
 	err := protomsg.Call(ctx, protomsg.CallArgs{
-		Client:  http.DefaultClient,
+		Client:  c.client,
 		Addr:    "http://" + c.addr,
 		URLPath: metricsEndpoint,
 		Reply:   metrics,
@@ -67,7 +76,7 @@ func (c *Client) Metrics(ctx context.Context) (*Metrics, error) {
 func (c *Client) Profile(ctx context.Context, req *protos.GetProfileRequest) (*protos.GetProfileReply, error) {
 	reply := &protos.GetProfileReply{}
 	err := protomsg.Call(ctx, protomsg.CallArgs{
-		Client:  http.DefaultClient,
+		Client:  c.client,
 		Addr:    "http://" + c.addr,
 		URLPath: profileEndpoint,
 		Request: req,
@@ -75,3 +84,43 @@ func (c *Client) Profile(ctx context.Context, req *protos.GetProfileRequest) (*p
 	})
 	return reply, err
 }
+
+// CallComponent implements the Server interface.
+func (c *Client) CallComponent(ctx context.Context, req *protos.CallComponentRequest) (*protos.CallComponentReply, error) {
+	reply := &protos.CallComponentReply{}
+	err := protomsg.Call(ctx, protomsg.CallArgs{
+		Client:  c.client,
+		Addr:    "http://" + c.addr,
+		URLPath: callEndpoint,
+		Request: req,
+		Reply:   reply,
+	})
+	return reply, err
+}
+
+// SetLogLevel implements the Server interface.
+func (c *Client) SetLogLevel(ctx context.Context, req *protos.SetLogLevelRequest) (*protos.SetLogLevelReply, error) {
+	reply := &protos.SetLogLevelReply{}
+	err := protomsg.Call(ctx, protomsg.CallArgs{
+		Client:  c.client,
+		Addr:    "http://" + c.addr,
+		URLPath: logLevelEndpoint,
+		Request: req,
+		Reply:   reply,
+	})
+	return reply, err
+}
+
+// tokenTransport is an http.RoundTripper that attaches a deployment token to
+// every request.
+type tokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(tokenHeader, t.token)
+	return t.base.RoundTrip(req)
+}
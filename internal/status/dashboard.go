@@ -18,16 +18,20 @@ import (
 	"bytes"
 	"context"
 	"embed"
+	"errors"
 	"flag"
 	"fmt"
 	"html/template"
+	"io"
 	"net"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/ServiceWeaver/weaver/internal/heap"
 	"github.com/ServiceWeaver/weaver/internal/traceio"
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
 	"github.com/ServiceWeaver/weaver/runtime/logging"
@@ -38,6 +42,7 @@ import (
 	dtool "github.com/ServiceWeaver/weaver/runtime/tool"
 	"github.com/ServiceWeaver/weaver/runtime/traces"
 	"github.com/pkg/browser"
+	"go.opentelemetry.io/otel/trace"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -77,6 +82,27 @@ var (
 		},
 	}).Parse(tracesHTML))
 
+	//go:embed templates/logs.html
+	logsHTML     string
+	logsTemplate = template.Must(template.New("logs").Funcs(template.FuncMap{
+		"shorten": logging.ShortenComponent,
+		"short":   logging.Shorten,
+		"base":    filepath.Base,
+		"time": func(micros int64) string {
+			return time.UnixMicro(micros).Format("2006-01-02 15:04:05.000000")
+		},
+		"attrs": func(attrs []string) string {
+			var b strings.Builder
+			for i := 0; i+1 < len(attrs); i += 2 {
+				if i > 0 {
+					b.WriteString(" ")
+				}
+				fmt.Fprintf(&b, "%s=%q", attrs[i], attrs[i+1])
+			}
+			return b.String()
+		},
+	}).Parse(logsHTML))
+
 	//go:embed assets/*
 	assets embed.FS
 )
@@ -94,6 +120,10 @@ type DashboardSpec struct {
 	PerfettoFile string                                   // perfetto database file
 	Registry     func(context.Context) (*Registry, error) // registry of deployments
 	Commands     func(deploymentId string) []Command      // commands for a deployment
+
+	// LogSource, if not nil, returns the source of the deployments' logs. The
+	// dashboard uses it to show the logs of a trace.
+	LogSource func(context.Context) (logging.Source, error)
 }
 
 // DashboardCommand returns a "dashboard" subcommand that serves a dashboard
@@ -134,6 +164,7 @@ Flags:
 			http.HandleFunc("/metrics", dashboard.handleMetrics)
 			http.HandleFunc("/traces", dashboard.handleTraces)
 			http.HandleFunc("/tracefetch", dashboard.handleTraceFetch)
+			http.HandleFunc("/logs", dashboard.handleLogs)
 			http.Handle("/assets/", http.FileServer(http.FS(assets)))
 
 			lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", *dashboardHost, *dashboardPort))
@@ -312,6 +343,14 @@ func (d *dashboard) handleMetrics(w http.ResponseWriter, r *http.Request) {
 	w.Write(b.Bytes())
 }
 
+// traceSearch holds the trace search parameters entered on the /traces page.
+type traceSearch struct {
+	Component string // component name
+	Method    string // method name
+	Attr      string // span attribute, as key or key=value
+	Route     string // HTTP route
+}
+
 // handleTraces handles requests to /traces?id=<deployment id>
 func (d *dashboard) handleTraces(w http.ResponseWriter, r *http.Request) {
 	if d.traceDB == nil {
@@ -345,6 +384,13 @@ func (d *dashboard) handleTraces(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	onlyErrors := r.URL.Query().Get("errs") != ""
+	attrKey, attrValue, _ := strings.Cut(r.URL.Query().Get("attr"), "=")
+	search := traceSearch{
+		Component: r.URL.Query().Get("component"),
+		Method:    r.URL.Query().Get("method"),
+		Attr:      r.URL.Query().Get("attr"),
+		Route:     r.URL.Query().Get("route"),
+	}
 
 	// Weavelets export traces every 5 seconds. In order to (semi-)guarantee
 	// that the database contains all spans for the selected traces, we only
@@ -354,7 +400,19 @@ func (d *dashboard) handleTraces(w http.ResponseWriter, r *http.Request) {
 	endTime := time.Now().Add(-1 * (traceio.ExportInterval + gracePeriod))
 
 	const maxNumTraces = 100
-	ts, err := d.traceDB.QueryTraces(r.Context(), "" /*app*/, id, time.Time{} /*startTime*/, endTime, latencyLower, latencyUpper, onlyErrors, maxNumTraces)
+	ts, err := d.traceDB.Search(r.Context(), traces.Query{
+		Version:        id,
+		EndTime:        endTime,
+		DurationLower:  latencyLower,
+		DurationUpper:  latencyUpper,
+		OnlyErrors:     onlyErrors,
+		Component:      search.Component,
+		Method:         search.Method,
+		AttributeKey:   attrKey,
+		AttributeValue: attrValue,
+		Route:          search.Route,
+		Limit:          maxNumTraces,
+	})
 	if err != nil {
 		http.Error(w, fmt.Sprintf("cannot query trace database: %v", err), http.StatusInternalServerError)
 		return
@@ -363,11 +421,15 @@ func (d *dashboard) handleTraces(w http.ResponseWriter, r *http.Request) {
 	content := struct {
 		Tool   string
 		ID     string
+		Search traceSearch
 		Traces []traces.TraceSummary
+		Logs   bool
 	}{
 		Tool:   d.spec.Tool,
 		ID:     id,
+		Search: search,
 		Traces: ts,
+		Logs:   d.spec.LogSource != nil,
 	}
 	if err := tracesTemplate.Execute(w, content); err != nil {
 		http.Error(w, fmt.Sprintf("cannot display traces: %v", err), http.StatusInternalServerError)
@@ -402,3 +464,79 @@ func (d *dashboard) handleTraceFetch(w http.ResponseWriter, r *http.Request) {
 	}
 	w.Write(data)
 }
+
+// handleLogs handles requests to /logs?id=<deployment id>&trace_id=<trace_id>.
+func (d *dashboard) handleLogs(w http.ResponseWriter, r *http.Request) {
+	if d.spec.LogSource == nil {
+		http.Error(w, "logs are not available", http.StatusNotFound)
+		return
+	}
+	id := r.URL.Query().Get("id")
+	if id == "" {
+		http.Error(w, "no deployment id provided", http.StatusBadRequest)
+		return
+	}
+	traceID := r.URL.Query().Get("trace_id")
+	if _, err := trace.TraceIDFromHex(traceID); err != nil {
+		http.Error(w, fmt.Sprintf("invalid trace id %q", traceID), http.StatusBadRequest)
+		return
+	}
+
+	source, err := d.spec.LogSource(r.Context())
+	if err != nil {
+		http.Error(w, fmt.Sprintf("cannot open logs: %v", err), http.StatusInternalServerError)
+		return
+	}
+	query := fmt.Sprintf("full_version == %q && trace_id == %q", id, traceID)
+	reader, err := source.Query(r.Context(), query, false)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("cannot query logs: %v", err), http.StatusInternalServerError)
+		return
+	}
+	defer reader.Close()
+
+	// Note that a trace's log entries are not necessarily read in timestamp
+	// order, so we read all of them and keep the earliest maxNumEntries
+	// entries in a heap ordered from latest to earliest.
+	const maxNumEntries = 1000
+	latest := heap.New(func(x, y *protos.LogEntry) bool {
+		return x.TimeMicros > y.TimeMicros
+	})
+	truncated := false
+	for {
+		entry, err := reader.Read(r.Context())
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			http.Error(w, fmt.Sprintf("cannot read logs: %v", err), http.StatusInternalServerError)
+			return
+		}
+		latest.Push(entry)
+		if latest.Len() > maxNumEntries {
+			truncated = true
+			latest.Pop()
+		}
+	}
+	entries := make([]*protos.LogEntry, latest.Len())
+	for i := len(entries) - 1; i >= 0; i-- {
+		entries[i], _ = latest.Pop()
+	}
+
+	content := struct {
+		Tool      string
+		ID        string
+		TraceID   string
+		Entries   []*protos.LogEntry
+		Truncated bool
+	}{
+		Tool:      d.spec.Tool,
+		ID:        id,
+		TraceID:   traceID,
+		Entries:   entries,
+		Truncated: truncated,
+	}
+	if err := logsTemplate.Execute(w, content); err != nil {
+		http.Error(w, fmt.Sprintf("cannot display logs: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"strings"
+	"text/template"
+
+	protos "github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/tool"
+)
+
+var (
+	logLevelFlags = flag.NewFlagSet("loglevel", flag.ContinueOnError)
+	logLevelTTL   = logLevelFlags.Duration("ttl", 0, "Restore the base log level after this duration")
+)
+
+// LogLevelCommand returns a "loglevel" subcommand that changes the minimum log
+// level of a component in a running deployment.
+func LogLevelCommand(toolName string, registry func(context.Context) (*Registry, error)) *tool.Command {
+	const help = `Usage:
+  {{.Tool}} loglevel [options] <deployment> <component> <level>
+
+Flags:
+  -h, --help	Print this help message.
+{{.Flags}}
+
+Description:
+  '{{.Tool}} loglevel <deployment> <component> <level>' changes the minimum
+  level of the log entries logged by a component in a running deployment,
+  without restarting the component. Log entries below <level> are dropped.
+  <level> is one of debug, info, warn, or error. The level "default"
+  restores the component's base level, which is info unless the component
+  is assigned a different level in the log_levels section of the config.
+
+  <deployment> is the id of the deployment which can be found using
+  '{{.Tool}} status' or '{{.Tool}} dashboard'. Like '{{.Tool}} call',
+  '{{.Tool}} loglevel' accepts a uniquely identifying prefix of a deployment
+  id and a suffix of a component name.
+
+  The new level applies to every running replica of the component. If --ttl
+  is provided, the base level is restored automatically after the
+  provided duration. Replicas started after the level is changed (e.g., to
+  replace a failed replica) also use the new level.
+
+Examples:
+  # Only log warnings and errors from the reverser/Reverser component.
+  {{.Tool}} loglevel 2c80d811 reverser/Reverser warn
+
+  # Log entries of every level for five minutes.
+  {{.Tool}} loglevel --ttl=5m 2c80d811 reverser/Reverser debug
+
+  # Restore the base level.
+  {{.Tool}} loglevel 2c80d811 reverser/Reverser default`
+	var b strings.Builder
+	t := template.Must(template.New(toolName).Parse(help))
+	content := struct{ Tool, Flags string }{toolName, tool.FlagsHelp(logLevelFlags)}
+	if err := t.Execute(&b, content); err != nil {
+		panic(err)
+	}
+
+	return &tool.Command{
+		Name:        "loglevel",
+		Description: "Change the log level of a running Service Weaver component",
+		Help:        b.String(),
+		Flags:       logLevelFlags,
+		Fn: func(ctx context.Context, args []string) error {
+			// Validate command line arguments.
+			usage := fmt.Errorf("usage: %s loglevel [options] <deployment> <component> <level>", toolName)
+			if len(args) != 3 {
+				return usage
+			}
+			prefix, component, level := args[0], args[1], args[2]
+			if prefix == "" || component == "" {
+				return usage
+			}
+			if level == "default" {
+				level = ""
+			} else {
+				var l slog.Level
+				if err := l.UnmarshalText([]byte(level)); err != nil {
+					return fmt.Errorf("invalid level %q; want debug, info, warn, error, or default", level)
+				}
+			}
+			if *logLevelTTL < 0 {
+				return fmt.Errorf("invalid --ttl %v: must be positive", *logLevelTTL)
+			}
+
+			// Get the corresponding deployment.
+			reg, err := findDeployment(ctx, registry, prefix)
+			if err != nil {
+				return err
+			}
+			client := NewAuthorizedClient(reg.Addr, reg.Token)
+
+			// Get the full component name.
+			status, err := client.Status(ctx)
+			if err != nil {
+				return err
+			}
+			component, err = resolveComponent(status, component)
+			if err != nil {
+				return err
+			}
+
+			// Change the level.
+			if _, err := client.SetLogLevel(ctx, &protos.SetLogLevelRequest{
+				Component: component,
+				Level:     level,
+				TtlNs:     logLevelTTL.Nanoseconds(),
+			}); err != nil {
+				return err
+			}
+			switch {
+			case level == "":
+				fmt.Printf("Restored the base log level of %s.\n", component)
+			case *logLevelTTL > 0:
+				fmt.Printf("Set the log level of %s to %s for %v.\n", component, level, *logLevelTTL)
+			default:
+				fmt.Printf("Set the log level of %s to %s.\n", component, level)
+			}
+			return nil
+		},
+	}
+}
@@ -91,32 +91,12 @@ Examples:
 				return fmt.Errorf("invalid profile type %q; want %q or %q", *profileType, "cpu", "heap")
 			}
 
-			// Get the corresponding deployment id.
-			registry, err := registry(ctx)
+			// Get the corresponding deployment.
+			reg, err := findDeployment(ctx, registry, prefix)
 			if err != nil {
-				return fmt.Errorf("create registry: %w", err)
-			}
-			regs, err := registry.List(ctx)
-			if err != nil {
-				return fmt.Errorf("get registrations: %w", err)
-			}
-			var candidates []Registration
-			for _, reg := range regs {
-				if strings.HasPrefix(reg.DeploymentId, prefix) {
-					candidates = append(candidates, reg)
-				}
-			}
-			if len(candidates) == 0 {
-				return fmt.Errorf("no deployment with prefix %q found", prefix)
-			}
-			if len(candidates) > 1 {
-				fmt.Fprintf(os.Stderr, "The deployment id prefix %q is ambiguous. Expand the prefix to identify one of the following deployments:\n", prefix)
-				for _, candidate := range candidates {
-					fmt.Fprintf(os.Stderr, "  - %s\n", candidate.DeploymentId)
-				}
-				return fmt.Errorf("multiple deployments with prefix %q found", prefix)
+				return err
 			}
-			client := NewClient(candidates[0].Addr) // candidates[0] is the only candidate
+			client := NewClient(reg.Addr)
 
 			// Get the deployment's status.
 			status, err := client.Status(ctx)
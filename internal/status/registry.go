@@ -54,6 +54,7 @@ type Registration struct {
 	DeploymentId string // deployment id (e.g, "eba18295")
 	App          string // app name (e.g., "todo")
 	Addr         string // status server (e.g., "localhost:12345")
+	Token        string // token authorizing calls and log level changes, if any
 }
 
 // Rolodex returns a pretty-printed rolodex displaying the registration.
@@ -46,6 +46,16 @@ func (f fakeClient) Profile(context.Context, *protos.GetProfileRequest) (*protos
 	return nil, fmt.Errorf("unimplemented")
 }
 
+// CallComponent implements the Server interface.
+func (f fakeClient) CallComponent(context.Context, *protos.CallComponentRequest) (*protos.CallComponentReply, error) {
+	return nil, fmt.Errorf("unimplemented")
+}
+
+// SetLogLevel implements the Server interface.
+func (f fakeClient) SetLogLevel(context.Context, *protos.SetLogLevelRequest) (*protos.SetLogLevelReply, error) {
+	return nil, fmt.Errorf("unimplemented")
+}
+
 func TestRegister(t *testing.T) {
 	// Create the registry.
 	ctx := context.Background()
@@ -56,10 +66,10 @@ func TestRegister(t *testing.T) {
 
 	// Register the deployments.
 	regs := []Registration{
-		{"0", "todo", "localhost:0"},
-		{"1", "todo", "localhost:1"},
-		{"2", "chat", "localhost:2"},
-		{"3", "zardoz", "localhost:3"},
+		{"0", "todo", "localhost:0", "token0"},
+		{"1", "todo", "localhost:1", ""},
+		{"2", "chat", "localhost:2", "token2"},
+		{"3", "zardoz", "localhost:3", ""},
 	}
 	for _, reg := range regs {
 		if err := registry.Register(ctx, reg); err != nil {
@@ -87,10 +97,10 @@ func TestUnregister(t *testing.T) {
 
 	// Register the deployments.
 	regs := []Registration{
-		{"0", "todo", "localhost:0"},
-		{"1", "todo", "localhost:1"},
-		{"2", "chat", "localhost:2"},
-		{"3", "zardoz", "localhost:3"},
+		{"0", "todo", "localhost:0", "token0"},
+		{"1", "todo", "localhost:1", ""},
+		{"2", "chat", "localhost:2", "token2"},
+		{"3", "zardoz", "localhost:3", ""},
 	}
 	for _, reg := range regs {
 		if err := registry.Register(ctx, reg); err != nil {
@@ -126,10 +136,10 @@ func TestListAndGet(t *testing.T) {
 
 	// Fake clients.
 	regs := []Registration{
-		{"0", "todo", "localhost:0"}, // running
-		{"1", "todo", "localhost:1"}, // unregistered
-		{"2", "chat", "localhost:2"}, // dead
-		{"3", "todo", "localhost:0"}, // superseded
+		{"0", "todo", "localhost:0", "token0"}, // running
+		{"1", "todo", "localhost:1", ""},       // unregistered
+		{"2", "chat", "localhost:2", "token2"}, // dead
+		{"3", "todo", "localhost:0", ""},       // superseded
 	}
 	registry.newClient = func(addr string) Server {
 		switch addr {
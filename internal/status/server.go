@@ -17,7 +17,9 @@ package status
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"log/slog"
+	"net"
 	"net/http"
 
 	"github.com/ServiceWeaver/weaver/runtime/metrics"
@@ -31,6 +33,12 @@ const (
 	metricsEndpoint    = "/debug/serviceweaver/metrics"
 	prometheusEndpoint = "/debug/serviceweaver/prometheus"
 	profileEndpoint    = "/debug/serviceweaver/profile"
+	callEndpoint       = "/debug/serviceweaver/call"
+	logLevelEndpoint   = "/debug/serviceweaver/loglevel"
+
+	// tokenHeader is the HTTP header that carries a deployment's token. See
+	// RegisterServer.
+	tokenHeader = "X-Serviceweaver-Token"
 )
 
 // A Server returns information about a Service Weaver deployment.
@@ -43,14 +51,29 @@ type Server interface {
 
 	// Profile returns a profile of the deployment.
 	Profile(context.Context, *protos.GetProfileRequest) (*protos.GetProfileReply, error)
+
+	// CallComponent calls a component method.
+	CallComponent(context.Context, *protos.CallComponentRequest) (*protos.CallComponentReply, error)
+
+	// SetLogLevel changes the minimum log level of a component.
+	SetLogLevel(context.Context, *protos.SetLogLevelRequest) (*protos.SetLogLevelReply, error)
 }
 
 // RegisterServer registers a Server's methods with the provided mux under the
 // /debug/serviceweaver/ prefix. You can use a Client to interact with a Status server.
-func RegisterServer(mux *http.ServeMux, server Server, logger *slog.Logger) {
+//
+// CallComponent and SetLogLevel let a caller run arbitrary component code and
+// change a deployment's behavior, so they are only registered if token is not
+// empty. Requests to them must come from a loopback address and carry the
+// token, which is stored in the deployment's Registration.
+func RegisterServer(mux *http.ServeMux, server Server, token string, logger *slog.Logger) {
 	mux.Handle(statusEndpoint, protomsg.HandlerThunk(logger, server.Status))
 	mux.Handle(metricsEndpoint, protomsg.HandlerThunk(logger, server.Metrics))
 	mux.Handle(profileEndpoint, protomsg.HandlerFunc(logger, server.Profile))
+	if token != "" {
+		mux.Handle(callEndpoint, authorize(token, protomsg.HandlerFunc(logger, server.CallComponent)))
+		mux.Handle(logLevelEndpoint, authorize(token, protomsg.HandlerFunc(logger, server.SetLogLevel)))
+	}
 	mux.HandleFunc(prometheusEndpoint, func(w http.ResponseWriter, r *http.Request) {
 		ms, err := server.Metrics(r.Context())
 		if err != nil {
@@ -66,3 +89,21 @@ func RegisterServer(mux *http.ServeMux, server Server, logger *slog.Logger) {
 		w.Write(b.Bytes())
 	})
 }
+
+// authorize returns a handler that forwards requests to h, but only if they
+// come from a loopback address and carry the provided token.
+func authorize(token string, h http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if ip := net.ParseIP(host); err != nil || ip == nil || !ip.IsLoopback() {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		got := r.Header.Get(tokenHeader)
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			http.Error(w, "invalid deployment token", http.StatusUnauthorized)
+			return
+		}
+		h(w, r)
+	})
+}
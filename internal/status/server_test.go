@@ -0,0 +1,84 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// privilegedServer is a fake Server whose CallComponent and SetLogLevel
+// methods always succeed.
+type privilegedServer struct{ fakeClient }
+
+// CallComponent implements the Server interface.
+func (privilegedServer) CallComponent(context.Context, *protos.CallComponentRequest) (*protos.CallComponentReply, error) {
+	return &protos.CallComponentReply{Results: "[]"}, nil
+}
+
+// SetLogLevel implements the Server interface.
+func (privilegedServer) SetLogLevel(context.Context, *protos.SetLogLevelRequest) (*protos.SetLogLevelReply, error) {
+	return &protos.SetLogLevelReply{}, nil
+}
+
+func TestPrivilegedEndpointAuthorization(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		token      string // token registered with the server
+		remoteAddr string // address of the caller
+		header     string // token sent by the caller
+		want       int    // HTTP status code
+	}{
+		{"Authorized", "secret", "127.0.0.1:1234", "secret", http.StatusOK},
+		{"AuthorizedIPv6", "secret", "[::1]:1234", "secret", http.StatusOK},
+		{"MissingToken", "secret", "127.0.0.1:1234", "", http.StatusUnauthorized},
+		{"WrongToken", "secret", "127.0.0.1:1234", "guess", http.StatusUnauthorized},
+		{"NotLoopback", "secret", "10.0.0.1:1234", "secret", http.StatusForbidden},
+		{"NoToken", "", "127.0.0.1:1234", "", http.StatusNotFound},
+	} {
+		for _, endpoint := range []string{callEndpoint, logLevelEndpoint} {
+			t.Run(test.name+"/"+path.Base(endpoint), func(t *testing.T) {
+				mux := http.NewServeMux()
+				logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+				RegisterServer(mux, privilegedServer{}, test.token, logger)
+
+				// An encoded, empty request.
+				enc := codegen.NewEncoder()
+				enc.Bytes(nil)
+				body := bytes.NewReader(enc.Data())
+
+				req := httptest.NewRequest(http.MethodPost, endpoint, body)
+				req.RemoteAddr = test.remoteAddr
+				if test.header != "" {
+					req.Header.Set(tokenHeader, test.header)
+				}
+				w := httptest.NewRecorder()
+				mux.ServeHTTP(w, req)
+				if got := w.Code; got != test.want {
+					t.Fatalf("status code: got %d, want %d", got, test.want)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/colors"
+	"github.com/ServiceWeaver/weaver/runtime/perfetto"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/tool"
+	"github.com/ServiceWeaver/weaver/runtime/traces"
+)
+
+var (
+	tracesFlags     = flag.NewFlagSet("traces", flag.ContinueOnError)
+	tracesApp       = tracesFlags.String("app", "", "Only show traces of this application")
+	tracesVersion   = tracesFlags.String("version", "", "Only show traces of this deployment (or deployment prefix)")
+	tracesSince     = tracesFlags.Duration("since", 0, "Only show traces that started within this duration (e.g., 1h)")
+	tracesMinLat    = tracesFlags.Duration("min-latency", 0, "Only show traces that took at least this long")
+	tracesMaxLat    = tracesFlags.Duration("max-latency", 0, "Only show traces that took less than this long")
+	tracesErrors    = tracesFlags.Bool("errors", false, "Only show traces with an error")
+	tracesComponent = tracesFlags.String("component", "", "Only show traces that call this component (e.g., cart/Cart)")
+	tracesMethod    = tracesFlags.String("method", "", "Only show traces that call this component method (e.g., Add)")
+	tracesAttr      = tracesFlags.String("attr", "", "Only show traces with a span that has this attribute, as key or key=value")
+	tracesRoute     = tracesFlags.String("route", "", "Only show traces started by HTTP requests to this route (e.g., /hello)")
+	tracesLimit     = tracesFlags.Int64("limit", 20, "Show at most this many traces, most recent first")
+	tracesExport    = tracesFlags.String("export", "", "Write the spans of the selected traces to this file, in Perfetto format")
+)
+
+// TracesCommand returns a "traces" subcommand that searches the traces stored
+// in the provided trace database file.
+func TracesCommand(toolName string, perfettoFile string) *tool.Command {
+	const help = `Usage:
+  {{.Tool}} traces [options]
+
+Flags:
+  -h, --help	Print this help message.
+{{.Flags}}
+
+Description:
+  '{{.Tool}} traces' prints a summary of the most recent traces that match
+  every provided flag. With --export, it also writes the spans of the
+  selected traces to a file that can be opened in the Perfetto UI
+  (https://ui.perfetto.dev).
+
+  Components are identified by their full names (e.g.,
+  github.com/example/shop/cart/Cart) or by a suffix of their full names that
+  starts after a slash (e.g., cart/Cart).
+
+Examples:
+  # Show the most recent traces.
+  {{.Tool}} traces
+
+  # Show traces with an error from the last hour.
+  {{.Tool}} traces --errors --since=1h
+
+  # Show slow traces that call the Add method of the cart/Cart component.
+  {{.Tool}} traces --component=cart/Cart --method=Add --min-latency=100ms
+
+  # Show traces of requests to the /checkout route, and export them.
+  {{.Tool}} traces --route=/checkout --export=checkout.json
+
+  # Show traces with a span that has the attribute user=alice.
+  {{.Tool}} traces --attr=user=alice`
+	var b strings.Builder
+	t := template.Must(template.New(toolName).Parse(help))
+	content := struct{ Tool, Flags string }{toolName, tool.FlagsHelp(tracesFlags)}
+	if err := t.Execute(&b, content); err != nil {
+		panic(err)
+	}
+
+	return &tool.Command{
+		Name:        "traces",
+		Description: "Search traces",
+		Help:        b.String(),
+		Flags:       tracesFlags,
+		Fn: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: %s traces [options]", toolName)
+			}
+			if *tracesSince < 0 || *tracesMinLat < 0 || *tracesMaxLat < 0 {
+				return fmt.Errorf("--since, --min-latency, and --max-latency must not be negative")
+			}
+			if *tracesMethod != "" && *tracesComponent == "" {
+				return fmt.Errorf("--method requires --component")
+			}
+			query := traces.Query{
+				App:           *tracesApp,
+				Version:       *tracesVersion,
+				DurationLower: *tracesMinLat,
+				DurationUpper: *tracesMaxLat,
+				OnlyErrors:    *tracesErrors,
+				Component:     *tracesComponent,
+				Method:        *tracesMethod,
+				Route:         *tracesRoute,
+				Limit:         *tracesLimit,
+			}
+			query.AttributeKey, query.AttributeValue, _ = strings.Cut(*tracesAttr, "=")
+			if *tracesSince > 0 {
+				query.StartTime = time.Now().Add(-*tracesSince)
+			}
+
+			db, err := traces.OpenDB(ctx, perfettoFile)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			summaries, err := db.Search(ctx, query)
+			if err != nil {
+				return err
+			}
+			formatTraces(os.Stdout, summaries)
+
+			if *tracesExport != "" {
+				return exportTraces(ctx, db, summaries, *tracesExport)
+			}
+			return nil
+		},
+	}
+}
+
+// formatTraces pretty-prints the provided trace summaries.
+func formatTraces(w io.Writer, summaries []traces.TraceSummary) {
+	title := []colors.Text{{{S: "TRACES", Bold: true}}}
+	t := colors.NewTabularizer(w, title, colors.NoDim)
+	defer t.Flush()
+	t.Row("APP", "DEPLOYMENT", "TRACE", "NAME", "ROUTE", "START", "LATENCY", "STATUS")
+	for _, s := range summaries {
+		prefix, _ := formatId(s.Version)
+		status := colors.Atom{S: s.Status}
+		if s.Status != "OK" {
+			status.Color = colors.Color256(9) // a light red
+		}
+		t.Row(s.App, prefix, s.TraceID, s.Name, s.Route,
+			s.StartTime.Format(time.RFC3339), s.EndTime.Sub(s.StartTime), status)
+	}
+}
+
+// exportTraces writes the spans of the provided traces to the provided file,
+// in a format that can be read by the Perfetto UI.
+func exportTraces(ctx context.Context, db *traces.DB, summaries []traces.TraceSummary, filename string) error {
+	var spans []*protos.Span
+	for _, s := range summaries {
+		traceSpans, err := db.FetchSpans(ctx, s.TraceID)
+		if err != nil {
+			return err
+		}
+		spans = append(spans, traceSpans...)
+	}
+	data, err := perfetto.EncodeSpans(spans)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filename, data, 0600); err != nil {
+		return err
+	}
+	fmt.Printf("Exported %d spans of %d traces to %s.\n", len(spans), len(summaries), filename)
+	return nil
+}
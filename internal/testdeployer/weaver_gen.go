@@ -31,7 +31,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return a_reflect_stub{caller: caller}
 		},
-		RefData: "⟦d473cf51:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/testdeployer/a→github.com/ServiceWeaver/weaver/internal/testdeployer/b⟧\n⟦83f71f4e:wEaVeRlIsTeNeRs:github.com/ServiceWeaver/weaver/internal/testdeployer/a→lis⟧\n",
+		RefData: "⟦d473cf51:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/testdeployer/a→github.com/ServiceWeaver/weaver/internal/testdeployer/b⟧\n⟦83f71f4e:wEaVeRlIsTeNeRs:github.com/ServiceWeaver/weaver/internal/testdeployer/a→lis⟧\n⟦e727dd34:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/testdeployer/a→;A=d94d815b⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:  "github.com/ServiceWeaver/weaver/internal/testdeployer/b",
@@ -49,7 +49,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return b_reflect_stub{caller: caller}
 		},
-		RefData: "⟦54fc5958:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/testdeployer/b→github.com/ServiceWeaver/weaver/internal/testdeployer/c⟧\n",
+		RefData: "⟦54fc5958:wEaVeReDgE:github.com/ServiceWeaver/weaver/internal/testdeployer/b→github.com/ServiceWeaver/weaver/internal/testdeployer/c⟧\n⟦668d2a8e:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/testdeployer/b→;B=d94d815b⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:  "github.com/ServiceWeaver/weaver/internal/testdeployer/c",
@@ -67,7 +67,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return c_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦40d836d0:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/testdeployer/c→;C=d94d815b⟧\n",
 	})
 }
 
@@ -99,7 +99,7 @@ func (s a_local_stub) A(ctx context.Context, a0 int) (r0 int, err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "testdeployer.a.A", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "testdeployer.a.A", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/testdeployer/a")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -128,7 +128,7 @@ func (s b_local_stub) B(ctx context.Context, a0 int) (r0 int, err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "testdeployer.b.B", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "testdeployer.b.B", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/testdeployer/b")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -157,7 +157,7 @@ func (s c_local_stub) C(ctx context.Context, a0 int) (r0 int, err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "testdeployer.c.C", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "testdeployer.c.C", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/testdeployer/c")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -189,7 +189,7 @@ func (s a_client_stub) A(ctx context.Context, a0 int) (r0 int, err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "testdeployer.a.A", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "testdeployer.a.A", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/testdeployer/a")))
 	}
 
 	defer func() {
@@ -253,7 +253,7 @@ func (s b_client_stub) B(ctx context.Context, a0 int) (r0 int, err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "testdeployer.b.B", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "testdeployer.b.B", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/testdeployer/b")))
 	}
 
 	defer func() {
@@ -317,7 +317,7 @@ func (s c_client_stub) C(ctx context.Context, a0 int) (r0 int, err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "testdeployer.c.C", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "testdeployer.c.C", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/testdeployer/c")))
 	}
 
 	defer func() {
@@ -367,10 +367,10 @@ func (s c_client_stub) C(ctx context.Context, a0 int) (r0 int, err error) {
 // Note that "weaver generate" will always generate the error message below.
 // Everything is okay. The error message is only relevant if you see it when
 // you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][20]struct{}](`
+var _ codegen.LatestVersion = codegen.Version[[0][23]struct{}](`
 
 ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.20.0). The generated code is incompatible with the version of the
+version v0.23.0). The generated code is incompatible with the version of the
 github.com/ServiceWeaver/weaver module that you're using. The weaver module
 version can be found in your go.mod file or by running the following command.
 
@@ -0,0 +1,326 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compat contains code to check whether two versions of a Service
+// Weaver application binary can be deployed side by side.
+package compat
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ServiceWeaver/weaver/runtime/bin"
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/graph"
+	"github.com/ServiceWeaver/weaver/runtime/logging"
+	"golang.org/x/exp/maps"
+)
+
+// Usage is the usage of the "weaver compat" command.
+const Usage = `Check the compatibility of two application binaries.
+
+Usage:
+  weaver compat <old binary> <new binary>
+
+Flags:
+  -h, --help           Print this help message.
+
+Description:
+  "weaver compat" compares the components, component methods, routing,
+  listeners, and call graphs embedded in two Service Weaver application
+  binaries, and reports whether the binaries can run side by side in a
+  mixed-version deployment, where calls from one version may be served by the
+  other.
+
+  A change is incompatible if it can make a call fail when the caller and the
+  callee run different versions:
+
+    - a method is added to or removed from a component;
+    - the types of a method's arguments or results are serialized differently;
+    - a field of a struct that uses tagged encoding (i.e., that has
+      weaver:"<tag>" field tags) changes type;
+    - the routing of a component changes; or
+    - the deployer API version changes.
+
+  All other changes, such as added or removed components, listeners, calls
+  between components, and tagged struct fields, are reported but are
+  compatible.
+
+  "weaver compat" exits with a non-zero status if the binaries are
+  incompatible.`
+
+// A Change is a difference between two binaries.
+type Change struct {
+	Component    string // the changed component, or empty for global changes
+	Description  string // a description of the change
+	Incompatible bool   // can the change make calls between versions fail?
+}
+
+// A Report describes the differences between two binaries.
+type Report struct {
+	Changes []Change
+}
+
+// Safe returns whether the two binaries can run side by side in a
+// mixed-version deployment, i.e., whether none of the changes are
+// incompatible.
+func (r *Report) Safe() bool {
+	for _, c := range r.Changes {
+		if c.Incompatible {
+			return false
+		}
+	}
+	return true
+}
+
+// Write writes a human readable version of the report to w.
+func (r *Report) Write(w io.Writer) {
+	if len(r.Changes) == 0 {
+		fmt.Fprintln(w, "No changes.")
+	}
+	incompatible := 0
+	for _, c := range r.Changes {
+		status := "compatible  "
+		if c.Incompatible {
+			status = "INCOMPATIBLE"
+			incompatible++
+		}
+		if c.Component == "" {
+			fmt.Fprintf(w, "%s %s\n", status, c.Description)
+		} else {
+			fmt.Fprintf(w, "%s %s: %s\n", status, logging.ShortenComponent(c.Component), c.Description)
+		}
+	}
+	fmt.Fprintln(w)
+	if incompatible == 0 {
+		fmt.Fprintln(w, "Mixed-version deployment is safe.")
+	} else {
+		fmt.Fprintf(w, "Mixed-version deployment is NOT safe: %d incompatible change(s).\n", incompatible)
+	}
+}
+
+// binary is the information embedded in an application binary.
+type binary struct {
+	versions   bin.Versions
+	components []codegen.ComponentInfo
+	edges      [][2]string
+	listeners  []codegen.ComponentListeners
+}
+
+// read reads the information embedded in the provided binary.
+func read(filename string) (*binary, error) {
+	versions, err := bin.ReadVersions(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read versions from %q: %w", filename, err)
+	}
+	components, err := bin.ReadComponents(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read components from %q: %w", filename, err)
+	}
+	if len(components) == 0 {
+		// Binaries generated by older versions of "weaver generate" don't
+		// embed their components.
+		return nil, fmt.Errorf("no components found in %q; rebuild it after running a newer \"weaver generate\"", filename)
+	}
+	names, g, err := bin.ReadComponentGraph(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read call graph from %q: %w", filename, err)
+	}
+	var edges [][2]string
+	graph.PerEdge(g, func(e graph.Edge) {
+		edges = append(edges, [2]string{names[e.Src], names[e.Dst]})
+	})
+	listeners, err := bin.ReadListeners(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read listeners from %q: %w", filename, err)
+	}
+	return &binary{
+		versions:   versions,
+		components: components,
+		edges:      edges,
+		listeners:  listeners,
+	}, nil
+}
+
+// Compare compares the provided binaries.
+func Compare(oldBinary, newBinary string) (*Report, error) {
+	before, err := read(oldBinary)
+	if err != nil {
+		return nil, err
+	}
+	after, err := read(newBinary)
+	if err != nil {
+		return nil, err
+	}
+	return compare(before, after), nil
+}
+
+// compare compares the provided binaries.
+func compare(before, after *binary) *Report {
+	var changes []Change
+	add := func(component string, incompatible bool, format string, args ...any) {
+		changes = append(changes, Change{
+			Component:    component,
+			Description:  fmt.Sprintf(format, args...),
+			Incompatible: incompatible,
+		})
+	}
+
+	// Compare versions.
+	if o, n := before.versions.DeployerVersion, after.versions.DeployerVersion; o != n {
+		add("", true, "deployer API version changed from %s to %s", o, n)
+	}
+	if o, n := before.versions.ModuleVersion, after.versions.ModuleVersion; o != n {
+		add("", false, "Service Weaver module version changed from %s to %s", o, n)
+	}
+
+	// Compare components.
+	oldComponents := map[string]codegen.ComponentInfo{}
+	for _, c := range before.components {
+		oldComponents[c.Component] = c
+	}
+	newComponents := map[string]codegen.ComponentInfo{}
+	for _, c := range after.components {
+		newComponents[c.Component] = c
+	}
+	for _, name := range union(maps.Keys(oldComponents), maps.Keys(newComponents)) {
+		o, inOld := oldComponents[name]
+		n, inNew := newComponents[name]
+		switch {
+		case !inOld:
+			add(name, false, "component added")
+			continue
+		case !inNew:
+			add(name, false, "component removed")
+			continue
+		}
+
+		switch {
+		case o.Router == "" && n.Router != "":
+			add(name, true, "component is now routed")
+		case o.Router != "" && n.Router == "":
+			add(name, true, "component is no longer routed")
+		case o.Router != n.Router:
+			add(name, true, "routing changed")
+		}
+
+		oldMethods := map[string]codegen.MethodInfo{}
+		for _, m := range o.Methods {
+			oldMethods[m.Name] = m
+		}
+		newMethods := map[string]codegen.MethodInfo{}
+		for _, m := range n.Methods {
+			newMethods[m.Name] = m
+		}
+		for _, method := range union(maps.Keys(oldMethods), maps.Keys(newMethods)) {
+			om, inOld := oldMethods[method]
+			nm, inNew := newMethods[method]
+			switch {
+			case !inOld:
+				add(name, true, "method %s added", method)
+			case !inNew:
+				add(name, true, "method %s removed", method)
+			case om.Hash != nm.Hash:
+				add(name, true, "method %s changed signature", method)
+			case !om.NoRetry && nm.NoRetry:
+				add(name, false, "method %s is no longer retried", method)
+			case om.NoRetry && !nm.NoRetry:
+				add(name, false, "method %s is now retried", method)
+			}
+		}
+
+		// Tagged struct fields are compared tag by tag. Decoders skip
+		// unknown tags, so only a change to an existing field is unsafe.
+		for _, field := range union(maps.Keys(o.TaggedFields), maps.Keys(n.TaggedFields)) {
+			of, inOld := o.TaggedFields[field]
+			nf, inNew := n.TaggedFields[field]
+			switch {
+			case !inOld:
+				add(name, false, "tagged field %s added", field)
+			case !inNew:
+				add(name, false, "tagged field %s removed", field)
+			case of != nf:
+				add(name, true, "tagged field %s changed type", field)
+			}
+		}
+	}
+
+	// Compare listeners.
+	listeners := func(b *binary) map[string]bool {
+		m := map[string]bool{}
+		for _, c := range b.listeners {
+			for _, l := range c.Listeners {
+				m[c.Component+"\x00"+l] = true
+			}
+		}
+		return m
+	}
+	oldListeners, newListeners := listeners(before), listeners(after)
+	for _, key := range union(maps.Keys(oldListeners), maps.Keys(newListeners)) {
+		component, listener := split(key)
+		switch {
+		case !oldListeners[key]:
+			add(component, false, "listener %q added", listener)
+		case !newListeners[key]:
+			add(component, false, "listener %q removed", listener)
+		}
+	}
+
+	// Compare call graphs.
+	edges := func(b *binary) map[string]bool {
+		m := map[string]bool{}
+		for _, e := range b.edges {
+			m[e[0]+"\x00"+e[1]] = true
+		}
+		return m
+	}
+	oldEdges, newEdges := edges(before), edges(after)
+	for _, key := range union(maps.Keys(oldEdges), maps.Keys(newEdges)) {
+		src, dst := split(key)
+		switch {
+		case !oldEdges[key]:
+			add(src, false, "now calls %s", logging.ShortenComponent(dst))
+		case !newEdges[key]:
+			add(src, false, "no longer calls %s", logging.ShortenComponent(dst))
+		}
+	}
+
+	// Report global changes first, followed by the changes to each component.
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Component < changes[j].Component
+	})
+	return &Report{Changes: changes}
+}
+
+// union returns the sorted union of the provided lists, without duplicates.
+func union(xs, ys []string) []string {
+	set := map[string]bool{}
+	for _, x := range xs {
+		set[x] = true
+	}
+	for _, y := range ys {
+		set[y] = true
+	}
+	result := maps.Keys(set)
+	sort.Strings(result)
+	return result
+}
+
+// split splits a key of the form a\x00b into a and b.
+func split(key string) (string, string) {
+	a, b, _ := strings.Cut(key, "\x00")
+	return a, b
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compat
+
+import (
+	"testing"
+
+	"github.com/ServiceWeaver/weaver/runtime/bin"
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/version"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompare(t *testing.T) {
+	const main = "github.com/ServiceWeaver/weaver/Main"
+	const foo = "example.com/app/Foo"
+	const bar = "example.com/app/Bar"
+	const baz = "example.com/app/Baz"
+	versions := bin.Versions{ModuleVersion: "v0.22.0", DeployerVersion: version.SemVer{Major: 0, Minor: 22}}
+
+	before := &binary{
+		versions: versions,
+		components: []codegen.ComponentInfo{
+			{Component: main},
+			{Component: foo, Methods: []codegen.MethodInfo{
+				{Name: "Get", Hash: "00000001"},
+				{Name: "Put", Hash: "00000002"},
+				{Name: "Delete", Hash: "00000003"},
+				{Name: "Scan", Hash: "00000004"},
+			}, TaggedFields: map[string]string{
+				"example.com/app.Pair#1": "0000000c",
+				"example.com/app.Pair#2": "0000000d",
+				"example.com/app.Pair#3": "0000000e",
+			}},
+			{Component: bar, Router: "0000000a", Methods: []codegen.MethodInfo{
+				{Name: "Ping", Hash: "00000005"},
+			}},
+			{Component: baz},
+		},
+		edges:     [][2]string{{main, foo}, {foo, bar}},
+		listeners: []codegen.ComponentListeners{{Component: main, Listeners: []string{"lis"}}},
+	}
+	after := &binary{
+		versions: versions,
+		components: []codegen.ComponentInfo{
+			{Component: main},
+			{Component: foo, Methods: []codegen.MethodInfo{
+				{Name: "Get", Hash: "00000001"},                 // unchanged
+				{Name: "Put", Hash: "00000020"},                 // changed
+				{Name: "Scan", Hash: "00000004", NoRetry: true}, // no longer retried
+				{Name: "List", Hash: "00000006"},                // added
+			}, TaggedFields: map[string]string{
+				"example.com/app.Pair#1": "0000000c", // unchanged
+				"example.com/app.Pair#2": "000000d0", // changed
+				"example.com/app.Pair#4": "0000000f", // added
+			}},
+			{Component: bar, Router: "0000000b", Methods: []codegen.MethodInfo{
+				{Name: "Ping", Hash: "00000005"},
+			}},
+		},
+		edges: [][2]string{{main, foo}, {main, bar}},
+		listeners: []codegen.ComponentListeners{
+			{Component: main, Listeners: []string{"lis", "admin"}},
+		},
+	}
+
+	report := compare(before, after)
+	want := []Change{
+		{Component: bar, Description: "routing changed", Incompatible: true},
+		{Component: baz, Description: "component removed"},
+		{Component: foo, Description: "method Delete removed", Incompatible: true},
+		{Component: foo, Description: "method List added", Incompatible: true},
+		{Component: foo, Description: "method Put changed signature", Incompatible: true},
+		{Component: foo, Description: "method Scan is no longer retried"},
+		{Component: foo, Description: "tagged field example.com/app.Pair#2 changed type", Incompatible: true},
+		{Component: foo, Description: "tagged field example.com/app.Pair#3 removed"},
+		{Component: foo, Description: "tagged field example.com/app.Pair#4 added"},
+		{Component: foo, Description: "no longer calls app.Bar"},
+		{Component: main, Description: `listener "admin" added`},
+		{Component: main, Description: "now calls app.Bar"},
+	}
+	if diff := cmp.Diff(want, report.Changes); diff != "" {
+		t.Fatalf("compare (-want +got):\n%s", diff)
+	}
+	if report.Safe() {
+		t.Fatal("compare: unexpectedly safe")
+	}
+
+	// A binary is compatible with itself.
+	if report := compare(before, before); len(report.Changes) != 0 || !report.Safe() {
+		t.Fatalf("compare(before, before): got %v, want no changes", report.Changes)
+	}
+
+	// A change to the deployer API version is incompatible.
+	newer := *after
+	newer.versions.DeployerVersion.Minor++
+	if report := compare(after, &newer); report.Safe() {
+		t.Fatalf("compare: changed deployer version is unexpectedly safe")
+	}
+}
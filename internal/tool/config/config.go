@@ -59,5 +59,12 @@ func GetDeployerConfig[T, L any, TP configProtoPointer[T, L]](key, shortKey stri
 			return nil, fmt.Errorf("listeners %s specified in the config not found in the binary", lis)
 		}
 	}
+	components, _, err := bin.ReadComponentGraph(app.Binary)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read components from binary %s: %w", app.Binary, err)
+	}
+	if err := runtime.CheckComponentNames(app, components); err != nil {
+		return nil, err
+	}
 	return config, nil
 }
@@ -0,0 +1,424 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// generateGateways generates an HTTP/JSON gateway for every component that
+// is declared a weaver.Gateway. For a component Foo, the generated
+// NewFooGateway function (newFooGateway if Foo is unexported) returns an
+// http.Handler that serves every method of Foo at "POST /Foo/<Method>" and an
+// OpenAPI description of the gateway at "GET /Foo/openapi.json". See
+// weaver.Gateway and codegen.ReadGatewayArgs for details.
+func (g *generator) generateGateways(p printFn) {
+	var comps []*component
+	for _, comp := range g.components {
+		if comp.gateway {
+			comps = append(comps, comp)
+		}
+	}
+	if len(comps) == 0 {
+		return
+	}
+
+	p(``)
+	p(``)
+	p(`// Gateways.`)
+
+	ts := g.tset.genTypeString
+	http := g.tset.importPackage("net/http", "http")
+	for _, comp := range comps {
+		name := comp.intfName()
+		openapi := notExported(name) + "_openapi"
+
+		// The gateway constructor is exported only if the component
+		// interface is exported.
+		constructor := "New" + name + "Gateway"
+		if !comp.intf.Obj().Exported() {
+			constructor = "new" + exported(name) + "Gateway"
+		}
+		p(``)
+		p(`// %s returns an http.Handler that serves the methods of the %s`, constructor, name)
+		p(`// component as JSON over HTTP. See weaver.Gateway for details.`)
+		p(`func %s(c %s) %s {`, constructor, g.componentRef(comp), http.qualify("Handler"))
+		p(`	mux := %s()`, http.qualify("NewServeMux"))
+		p(`	mux.HandleFunc("/%s/openapi.json", func(w %s, r *%s) {`, name, http.qualify("ResponseWriter"), http.qualify("Request"))
+		p(`		%s(w, r, %s)`, g.codegen().qualify("WriteGatewayOpenAPI"), openapi)
+		p(`	})`)
+		for _, m := range comp.methods() {
+			mt := m.Type().(*types.Signature)
+			var args, ptrs, results []string
+			for i := 1; i < mt.Params().Len(); i++ {
+				a := fmt.Sprintf("a%d", i-1)
+				ptrs = append(ptrs, "&"+a)
+				if mt.Variadic() && i == mt.Params().Len()-1 {
+					a += "..."
+				}
+				args = append(args, a)
+			}
+			for i := 0; i < mt.Results().Len()-1; i++ {
+				results = append(results, fmt.Sprintf("r%d", i))
+			}
+
+			p(`	mux.HandleFunc("/%s/%s", func(w %s, r *%s) {`, name, m.Name(), http.qualify("ResponseWriter"), http.qualify("Request"))
+			for i := 1; i < mt.Params().Len(); i++ {
+				p(`		var a%d %s`, i-1, ts(mt.Params().At(i).Type()))
+			}
+			p(`		if !%s(%s) {`, g.codegen().qualify("ReadGatewayArgs"), strings.Join(append([]string{"w", "r"}, ptrs...), ", "))
+			p(`			return`)
+			p(`		}`)
+			p(`		%s := c.%s(%s)`, strings.Join(append(results, "err"), ", "), m.Name(), strings.Join(append([]string{"r.Context()"}, args...), ", "))
+			p(`		%s(%s)`, g.codegen().qualify("WriteGatewayResults"), strings.Join(append([]string{"w", "err"}, results...), ", "))
+			p(`	})`)
+		}
+		p(`	return mux`)
+		p(`}`)
+		p(``)
+		p(`// %s is the OpenAPI description of the %s gateway.`, openapi, name)
+		p("const %s = `%s`", openapi, g.openAPI(comp))
+	}
+}
+
+// validateGateway checks that the methods of the provided gateway component
+// can be served as JSON, i.e., that their arguments can be decoded from JSON
+// and that their arguments and results can be encoded as JSON without losing
+// every field.
+func validateGateway(fset *token.FileSet, comp *component) error {
+	var errs []error
+	for _, m := range comp.methods() {
+		mt := m.Type().(*types.Signature)
+		for i := 1; i < mt.Params().Len(); i++ {
+			if err := checkJSON(mt.Params().At(i).Type(), true); err != nil {
+				errs = append(errs, errorf(fset, m.Pos(), "gateway method %s.%s: argument %d cannot be sent as JSON: %w", comp.intfName(), m.Name(), i, err))
+			}
+		}
+		for i := 0; i < mt.Results().Len()-1; i++ {
+			if err := checkJSON(mt.Results().At(i).Type(), false); err != nil {
+				errs = append(errs, errorf(fset, m.Pos(), "gateway method %s.%s: result %d cannot be sent as JSON: %w", comp.intfName(), m.Name(), i, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkJSON returns an error if values of type t cannot be encoded as JSON by
+// the encoding/json package, or if they can only be encoded as an empty
+// object because they have no exported fields. If decode is true, checkJSON
+// also returns an error if values of type t cannot be decoded from JSON.
+//
+// REQUIRES: t is serializable.
+func checkJSON(t types.Type, decode bool) error {
+	var stack typeutil.Map // named types being checked, to handle recursive types
+	var check func(t types.Type) error
+	check = func(t types.Type) error {
+		if isTime(t) || hasMethod(t, "MarshalJSON") || hasMethod(t, "MarshalText") {
+			// The type has a custom JSON encoding.
+			return nil
+		}
+		if n, ok := t.(*types.Named); ok {
+			if stack.At(n) != nil {
+				return nil
+			}
+			stack.Set(n, true)
+			defer stack.Delete(n)
+		}
+
+		switch x := t.Underlying().(type) {
+		case *types.Basic:
+			if x.Info()&types.IsComplex != 0 {
+				return fmt.Errorf("%v is a complex number type", t)
+			}
+			return nil
+
+		case *types.Pointer:
+			return check(x.Elem())
+
+		case *types.Slice:
+			return check(x.Elem())
+
+		case *types.Array:
+			return check(x.Elem())
+
+		case *types.Map:
+			// See https://pkg.go.dev/encoding/json#Marshal for map keys.
+			k, ok := x.Key().Underlying().(*types.Basic)
+			if !hasMethod(x.Key(), "MarshalText") && (!ok || k.Info()&(types.IsString|types.IsInteger) == 0) {
+				return fmt.Errorf("map key type %v is not a string, an integer, or an encoding.TextMarshaler", x.Key())
+			}
+			return check(x.Elem())
+
+		case *types.Interface:
+			if decode {
+				return fmt.Errorf("%v is an interface type, so its values cannot be decoded", t)
+			}
+			return nil
+
+		case *types.Struct:
+			var fields []*types.Var
+			hidden := 0
+			var visit func(s *types.Struct)
+			visit = func(s *types.Struct) {
+				for i := 0; i < s.NumFields(); i++ {
+					f := s.Field(i)
+					if isWeaverAutoMarshal(f.Type()) {
+						continue
+					}
+					name, _, _ := strings.Cut(reflect.StructTag(s.Tag(i)).Get("json"), ",")
+					if f.Embedded() && name == "" {
+						if e, ok := f.Type().Underlying().(*types.Struct); ok {
+							if _, isNamed := f.Type().(*types.Named); isNamed {
+								visit(e)
+								continue
+							}
+						}
+					}
+					if !f.Exported() || name == "-" {
+						hidden++
+						continue
+					}
+					fields = append(fields, f)
+				}
+			}
+			visit(x)
+			if len(fields) == 0 && hidden > 0 {
+				return fmt.Errorf("%v has no exported fields, so its values would be sent as {}", t)
+			}
+			for _, f := range fields {
+				if err := check(f.Type()); err != nil {
+					return fmt.Errorf("field %s: %w", f.Name(), err)
+				}
+			}
+			return nil
+
+		default:
+			return fmt.Errorf("%v is not supported by encoding/json", t)
+		}
+	}
+	return check(t)
+}
+
+// openAPI returns an OpenAPI 3.1 description, in JSON, of the gateway for
+// the provided component. See https://spec.openapis.org/oas/v3.1.0.
+func (g *generator) openAPI(comp *component) string {
+	schemas := map[string]any{}
+	paths := map[string]any{}
+	for _, m := range comp.methods() {
+		mt := m.Type().(*types.Signature)
+		var args, results []any
+		for i := 1; i < mt.Params().Len(); i++ {
+			args = append(args, g.jsonSchema(mt.Params().At(i).Type(), schemas))
+		}
+		for i := 0; i < mt.Results().Len()-1; i++ {
+			results = append(results, g.jsonSchema(mt.Results().At(i).Type(), schemas))
+		}
+		paths[fmt.Sprintf("/%s/%s", comp.intfName(), m.Name())] = map[string]any{
+			"post": map[string]any{
+				"operationId": m.Name(),
+				"requestBody": map[string]any{
+					"required": true,
+					"content":  jsonContent(tuple(args)),
+				},
+				"responses": map[string]any{
+					"200": map[string]any{
+						"description": "The results of the call.",
+						"content":     jsonContent(tuple(results)),
+					},
+					"default": map[string]any{
+						"description": "The call failed.",
+						"content":     jsonContent(map[string]any{"$ref": "#/components/schemas/Error"}),
+					},
+				},
+			},
+		}
+	}
+	schemas["Error"] = map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"error": map[string]any{"type": "string"}},
+		"required":   []string{"error"},
+	}
+
+	// The version changes whenever the API of the component changes.
+	info := g.componentInfo(comp)
+	var hashes []string
+	for _, m := range info.Methods {
+		hashes = append(hashes, m.Name+"="+m.Hash)
+	}
+	doc := map[string]any{
+		"openapi": "3.1.0",
+		"info": map[string]any{
+			"title":   comp.fullIntfName(),
+			"version": codegen.HashSignature(strings.Join(hashes, ",")),
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": schemas},
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		panic(fmt.Sprintf("openAPI: %v", err))
+	}
+	s := string(data)
+	if strings.Contains(s, "`") {
+		// The description is emitted as a raw string literal.
+		panic(fmt.Sprintf("openAPI: unexpected backquote in %s", s))
+	}
+	return s
+}
+
+// jsonContent returns an OpenAPI content object for a JSON body with the
+// provided schema.
+func jsonContent(schema any) map[string]any {
+	return map[string]any{"application/json": map[string]any{"schema": schema}}
+}
+
+// tuple returns the JSON schema of an array whose elements have the provided
+// schemas.
+func tuple(items []any) map[string]any {
+	schema := map[string]any{
+		"type":     "array",
+		"minItems": len(items),
+		"maxItems": len(items),
+	}
+	if len(items) > 0 {
+		schema["prefixItems"] = items
+	}
+	return schema
+}
+
+// jsonSchema returns the JSON schema of the encoding/json encoding of values
+// of type t. Named struct types are described in schemas and referenced by
+// name. Types with a custom JSON encoding are described by the empty schema,
+// which accepts any value.
+func (g *generator) jsonSchema(t types.Type, schemas map[string]any) map[string]any {
+	if isTime(t) {
+		return map[string]any{"type": "string", "format": "date-time"}
+	}
+	if hasMethod(t, "MarshalJSON") {
+		return map[string]any{}
+	}
+	if hasMethod(t, "MarshalText") {
+		return map[string]any{"type": "string"}
+	}
+
+	switch x := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case x.Kind() == types.Bool:
+			return map[string]any{"type": "boolean"}
+		case x.Kind() == types.String:
+			return map[string]any{"type": "string"}
+		case x.Kind() == types.Int32 || x.Kind() == types.Uint32 || x.Kind() == types.Int16 ||
+			x.Kind() == types.Uint16 || x.Kind() == types.Int8 || x.Kind() == types.Uint8:
+			return map[string]any{"type": "integer", "format": "int32"}
+		case x.Info()&types.IsInteger != 0:
+			return map[string]any{"type": "integer", "format": "int64"}
+		case x.Kind() == types.Float32:
+			return map[string]any{"type": "number", "format": "float"}
+		case x.Info()&types.IsFloat != 0:
+			return map[string]any{"type": "number", "format": "double"}
+		}
+
+	case *types.Pointer:
+		return g.jsonSchema(x.Elem(), schemas)
+
+	case *types.Slice:
+		if b, ok := x.Elem().Underlying().(*types.Basic); ok && b.Kind() == types.Byte {
+			return map[string]any{"type": "string", "format": "byte"}
+		}
+		return map[string]any{"type": "array", "items": g.jsonSchema(x.Elem(), schemas)}
+
+	case *types.Array:
+		return map[string]any{
+			"type":     "array",
+			"items":    g.jsonSchema(x.Elem(), schemas),
+			"minItems": x.Len(),
+			"maxItems": x.Len(),
+		}
+
+	case *types.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.jsonSchema(x.Elem(), schemas)}
+
+	case *types.Struct:
+		n, ok := t.(*types.Named)
+		if !ok {
+			return g.structSchema(x, schemas)
+		}
+		name := n.Obj().Pkg().Name() + "." + n.Obj().Name()
+		if _, ok := schemas[name]; !ok {
+			schemas[name] = nil // placeholder, to handle recursive types
+			schemas[name] = g.structSchema(x, schemas)
+		}
+		return map[string]any{"$ref": "#/components/schemas/" + name}
+	}
+	return map[string]any{}
+}
+
+// structSchema returns the JSON schema of the encoding/json encoding of a
+// struct. Only exported fields are encoded, fields of embedded structs are
+// promoted, and fields are named by their json struct tags, if any.
+func (g *generator) structSchema(s *types.Struct, schemas map[string]any) map[string]any {
+	properties := map[string]any{}
+	var fields func(s *types.Struct)
+	fields = func(s *types.Struct) {
+		for i := 0; i < s.NumFields(); i++ {
+			f := s.Field(i)
+			name, _, _ := strings.Cut(reflect.StructTag(s.Tag(i)).Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			if f.Embedded() && name == "" {
+				if e, ok := f.Type().Underlying().(*types.Struct); ok {
+					if _, isNamed := f.Type().(*types.Named); isNamed {
+						fields(e)
+						continue
+					}
+				}
+			}
+			if !f.Exported() {
+				continue
+			}
+			if name == "" {
+				name = f.Name()
+			}
+			properties[name] = g.jsonSchema(f.Type(), schemas)
+		}
+	}
+	fields(s)
+	return map[string]any{"type": "object", "properties": properties}
+}
+
+// hasMethod returns whether t or *t has a method with the provided name.
+func hasMethod(t types.Type, name string) bool {
+	if _, ok := t.(*types.Pointer); !ok {
+		t = types.NewPointer(t)
+	}
+	return types.NewMethodSet(t).Lookup(nil, name) != nil
+}
+
+// isTime returns whether t is time.Time.
+func isTime(t types.Type) bool {
+	n, ok := t.(*types.Named)
+	return ok && n.Obj().Pkg() != nil && n.Obj().Pkg().Path() == "time" && n.Obj().Name() == "Time"
+}
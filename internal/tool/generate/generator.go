@@ -50,11 +50,12 @@ import (
 
 const (
 	generatedCodeFile = "weaver_gen.go"
+	generatedHeader   = `// Code generated by "weaver generate". DO NOT EDIT.`
 
 	Usage = `Generate code for a Service Weaver application.
 
 Usage:
-  weaver generate [packages]
+  weaver generate [--mocks] [packages]
 
 Description:
   "weaver generate" generates code for the Service Weaver applications in the
@@ -75,6 +76,15 @@ Description:
 
   and then use the normal "go generate" command.
 
+  If the --mocks flag is provided, "weaver generate" also generates a mock
+  implementation of every component interface in a weaver_gen_mock.go file.
+  For a component interface Foo, the mock is called MockFoo. Its Expect and
+  Stub methods specify how calls are handled, its Calls methods return the
+  recorded calls, and its Verify method reports unmet expectations. Mocks can
+  be used with weavertest.Fake to replace components in tests. Build with
+  "-tags=ignoreWeaverMocks" to leave the mocks out of a binary. Without the
+  --mocks flag, a previously generated weaver_gen_mock.go file is removed.
+
 Examples:
   # Generate code for the package in the current directory.
   weaver generate
@@ -86,13 +96,21 @@ Examples:
   weaver generate ./foo
 
   # Generate code for all packages in all subdirectories of current directory.
-  weaver generate ./...`
+  weaver generate ./...
+
+  # Generate code and mocks for the package in the current directory.
+  weaver generate --mocks .`
 )
 
 // Options controls the operation of Generate.
 type Options struct {
 	// If non-nil, use the specified function to report warnings.
 	Warn func(error)
+
+	// If true, also generate a mock implementation of every component
+	// interface in a weaver_gen_mock.go file. If false, remove a previously
+	// generated weaver_gen_mock.go file.
+	Mocks bool
 }
 
 // Generate generates Service Weaver code for the specified packages.
@@ -125,6 +143,15 @@ func Generate(dir string, pkgs []string, opt Options) error {
 		}
 		if err := g.generate(); err != nil {
 			errs = append(errs, err)
+			continue
+		}
+		if opt.Mocks {
+			err = g.generateMocks()
+		} else {
+			err = g.removeMocks()
+		}
+		if err != nil {
+			errs = append(errs, err)
 		}
 	}
 	return errors.Join(errs...)
@@ -134,7 +161,7 @@ func Generate(dir string, pkgs []string, opt Options) error {
 // contents are ignored since those contents may reference types that no longer
 // exist.
 func parseNonWeaverGenFile(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
-	if filepath.Base(filename) == generatedCodeFile {
+	if isGeneratedFile(filename) {
 		return parser.ParseFile(fset, filename, src, parser.PackageClauseOnly)
 	}
 	return parser.ParseFile(fset, filename, src, parser.ParseComments|parser.DeclarationErrors)
@@ -184,7 +211,7 @@ func newGenerator(opt Options, pkg *packages.Package, fset *token.FileSet, autom
 	tset := newTypeSet(pkg, automarshals, &typeutil.Map{})
 	for _, file := range pkg.Syntax {
 		filename := fset.Position(file.Package).Filename
-		if filepath.Base(filename) == generatedCodeFile {
+		if isGeneratedFile(filename) {
 			// Ignore weaver_gen.go files.
 			continue
 		}
@@ -211,6 +238,10 @@ func newGenerator(opt Options, pkg *packages.Package, fset *token.FileSet, autom
 			errs = append(errs, errorf(fset, n.Obj().Pos(), "type %v is not serializable\n%w", t, err))
 			continue
 		}
+		if _, err := fieldTags(n.Underlying().(*types.Struct)); err != nil {
+			errs = append(errs, errorf(fset, n.Obj().Pos(), "type %v has invalid weaver tags\n%w", t, err))
+			continue
+		}
 		tset.automarshals.Set(t, struct{}{})
 	}
 	if err := errors.Join(errs...); err != nil {
@@ -221,7 +252,7 @@ func newGenerator(opt Options, pkg *packages.Package, fset *token.FileSet, autom
 	components := map[string]*component{}
 	for _, file := range pkg.Syntax {
 		filename := fset.Position(file.Package).Filename
-		if filepath.Base(filename) == generatedCodeFile {
+		if isGeneratedFile(filename) {
 			// Ignore weaver_gen.go files.
 			continue
 		}
@@ -252,7 +283,7 @@ func newGenerator(opt Options, pkg *packages.Package, fset *token.FileSet, autom
 	// Find method attributes.
 	for _, file := range pkg.Syntax {
 		filename := fset.Position(file.Package).Filename
-		if filepath.Base(filename) == generatedCodeFile {
+		if isGeneratedFile(filename) {
 			// Ignore weaver_gen.go files.
 			continue
 		}
@@ -261,6 +292,15 @@ func newGenerator(opt Options, pkg *packages.Package, fset *token.FileSet, autom
 		}
 	}
 
+	// Check that the methods of gateway components can be served as JSON.
+	for _, comp := range components {
+		if comp.gateway {
+			if err := validateGateway(fset, comp); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
 	}
@@ -309,6 +349,7 @@ func findComponents(opt Options, pkg *packages.Package, f *ast.File, tset *typeS
 func findMethodAttributes(pkg *packages.Package, f *ast.File, components map[string]*component) error {
 	// Look for declarations of the form:
 	//	var _ weaver.NotRetriable = Component.Method
+	//	var _ weaver.Gateway = Component(nil)
 	var errs []error
 	for _, decl := range f.Decls {
 		gendecl, ok := decl.(*ast.GenDecl)
@@ -325,6 +366,17 @@ func findMethodAttributes(pkg *packages.Package, f *ast.File, components map[str
 				continue
 			}
 			t := typeAndValue.Type
+			if isWeaverGateway(t) {
+				for _, val := range valspec.Values {
+					comp, ok := findComponent(pkg, components, val)
+					if !ok {
+						errs = append(errs, errorf(pkg.Fset, valspec.Pos(), "weaver.Gateway should only be assigned a value of a component interface type implemented by this package, e.g., Component(nil)"))
+						continue
+					}
+					comp.gateway = true
+				}
+				continue
+			}
 			if !isWeaverNotRetriable(t) {
 				continue
 			}
@@ -345,6 +397,21 @@ func findMethodAttributes(pkg *packages.Package, f *ast.File, components map[str
 	return errors.Join(errs...)
 }
 
+// findComponent returns the component if val is an expression whose type is
+// a component listed in components, e.g., Component(nil).
+func findComponent(pkg *packages.Package, components map[string]*component, val ast.Expr) (*component, bool) {
+	tv, ok := pkg.TypesInfo.Types[val]
+	if !ok {
+		return nil, false
+	}
+	t, ok := tv.Type.(*types.Named)
+	if !ok {
+		return nil, false
+	}
+	c, ok := components[fullName(t)]
+	return c, ok
+}
+
 // findComponentMethod returns the component and method if val is an expression of
 // the form C.M where C is a component listed in components and C has a method named M.
 func findComponentMethod(pkg *packages.Package, components map[string]*component, val ast.Expr) (*component, string, bool) {
@@ -480,10 +547,12 @@ func extractComponent(opt Options, pkg *packages.Package, file *ast.File, tset *
 		return nil, nil
 	}
 
-	// Find any weaver.Implements[T] or weaver.WithRouter[T] embedded fields.
+	// Find any weaver.Implements[T], weaver.WithRouter[T], or weaver.Singleton
+	// embedded fields.
 	var intf *types.Named   // The component interface type
 	var router *types.Named // Router type (if any)
 	var isMain bool         // Is intf weaver.Main?
+	var singleton bool      // Does the struct embed weaver.Singleton?
 	var refs []*types.Named // T for which weaver.Ref[T] exists in struct
 	var listeners []string  // Names of all listener fields declared in struct
 	for _, f := range s.Fields.List {
@@ -563,6 +632,10 @@ func extractComponent(opt Options, pkg *packages.Package, file *ast.File, tset *
 					formatType(pkg, named))
 			}
 			router = named
+
+		// The field f is an embedded weaver.Singleton.
+		case isWeaverSingleton(t):
+			singleton = true
 		}
 	}
 
@@ -580,6 +653,14 @@ func extractComponent(opt Options, pkg *packages.Package, file *ast.File, tset *
 			formatType(pkg, impl), formatType(pkg, intf), formatType(pkg, intf))
 	}
 
+	// Disallow routed singletons. All calls to a singleton component are sent
+	// to its leader, so there is nothing to route.
+	if singleton && router != nil {
+		return nil, errorf(pkg.Fset, spec.Pos(),
+			"component implementation %s embeds both weaver.Singleton and weaver.WithRouter. A singleton component cannot be routed.",
+			formatType(pkg, impl))
+	}
+
 	// Disallow generic component implementations.
 	if spec.TypeParams != nil && spec.TypeParams.NumFields() != 0 {
 		return nil, errorf(pkg.Fset, spec.Pos(),
@@ -615,6 +696,7 @@ func extractComponent(opt Options, pkg *packages.Package, file *ast.File, tset *
 		intf:      intf,
 		impl:      impl,
 		router:    router,
+		singleton: singleton,
 		isMain:    isMain,
 		refs:      refs,
 		listeners: listeners,
@@ -682,10 +764,12 @@ type component struct {
 	router        *types.Named        // router, or nil if there is no router
 	routingKey    types.Type          // routing key, or nil if there is no router
 	routedMethods map[string]bool     // the set of methods with a routing function
+	singleton     bool                // impl embeds weaver.Singleton
 	isMain        bool                // intf is weaver.Main
 	refs          []*types.Named      // List of T where a weaver.Ref[T] field is in impl struct
 	listeners     []string            // Names of listener fields declared in impl struct
 	noretry       map[string]struct{} // Methods that should not be retried
+	gateway       bool                // Generate an HTTP/JSON gateway?
 }
 
 func fullName(t *types.Named) string {
@@ -925,6 +1009,7 @@ func (g *generator) generate() error {
 		}
 		g.generateServerStubs(fn)
 		g.generateReflectStubs(fn)
+		g.generateGateways(fn)
 		g.generateAutoMarshalMethods(fn)
 		g.generateRouterMethods(fn)
 		g.generateEncDecMethods(fn)
@@ -951,7 +1036,7 @@ func (g *generator) generate() error {
 		fn := func(format string, args ...interface{}) {
 			fmt.Fprintln(&header, fmt.Sprintf(format, args...))
 		}
-		g.generateImports(fn)
+		g.generateImports(fn, "!ignoreWeaverGen")
 	}
 
 	// Create a generated file.
@@ -1001,10 +1086,11 @@ func (g *generator) componentRef(comp *component) string {
 	return comp.intfName() // We already checked that interface is in the same package.
 }
 
-// generateImports generates code to import all the dependencies.
-func (g *generator) generateImports(p printFn) {
-	p(`// Code generated by "weaver generate". DO NOT EDIT.`)
-	p("//go:build !ignoreWeaverGen")
+// generateImports generates code to import all the dependencies, preceded by
+// the provided build constraint.
+func (g *generator) generateImports(p printFn, constraint string) {
+	p(generatedHeader)
+	p("//go:build %s", constraint)
 	p("")
 	p("package %s", g.pkg.Name)
 	p("")
@@ -1232,6 +1318,7 @@ func (g *generator) generateRegisteredComponents(p printFn) {
 		if len(comp.listeners) > 0 {
 			refData.WriteString(codegen.MakeListenersString(myName, comp.listeners))
 		}
+		refData.WriteString(codegen.MakeComponentString(g.componentInfo(comp)))
 
 		// E.g.,
 		//	weaver.Register(weaver.Registration{
@@ -1248,6 +1335,14 @@ func (g *generator) generateRegisteredComponents(p printFn) {
 		if comp.router != nil {
 			p(`		Routed: true,`)
 		}
+		if comp.singleton {
+			p(`		Singleton: true,`)
+		}
+		if comp.routingKey != nil {
+			key := g.tset.genTypeString(comp.routingKey)
+			p(`		RoutingKey: %s((*%s)(nil)).Elem(),`, reflect.qualify("TypeOf"), key)
+			p(`		ShardKeyFn: func(key any) uint64 { return _hash%s(key.(%s)) },`, exported(name), key)
+		}
 		if len(comp.listeners) > 0 {
 			listeners := make([]string, len(comp.listeners))
 			for i, lis := range comp.listeners {
@@ -1268,6 +1363,46 @@ func (g *generator) generateRegisteredComponents(p printFn) {
 	p(`}`)
 }
 
+// componentInfo returns the methods and routing of the provided component, as
+// embedded in the binary by codegen.MakeComponentString.
+func (g *generator) componentInfo(comp *component) codegen.ComponentInfo {
+	info := codegen.ComponentInfo{Component: comp.fullIntfName()}
+	if comp.router != nil {
+		var routed []string
+		for m := range comp.routedMethods {
+			routed = append(routed, m)
+		}
+		sort.Strings(routed)
+		info.Router = codegen.HashSignature(fmt.Sprintf("%s;%s", g.tset.signature(comp.routingKey), strings.Join(routed, ",")))
+	}
+	fields := map[string]string{} // see ComponentInfo.TaggedFields
+	for _, m := range comp.methods() {
+		// The signature of a method includes its arguments, skipping the
+		// context.Context, and its results, skipping the error.
+		sig := m.Type().(*types.Signature)
+		var args, results []string
+		for i := 1; i < sig.Params().Len(); i++ {
+			args = append(args, g.tset.taggedSignature(sig.Params().At(i).Type(), fields))
+		}
+		for i := 0; i < sig.Results().Len()-1; i++ {
+			results = append(results, g.tset.taggedSignature(sig.Results().At(i).Type(), fields))
+		}
+		_, noretry := comp.noretry[m.Name()]
+		info.Methods = append(info.Methods, codegen.MethodInfo{
+			Name:    m.Name(),
+			Hash:    codegen.HashSignature(fmt.Sprintf("(%s)(%s)", strings.Join(args, ","), strings.Join(results, ","))),
+			NoRetry: noretry,
+		})
+	}
+	if len(fields) > 0 {
+		info.TaggedFields = map[string]string{}
+		for field, signature := range fields {
+			info.TaggedFields[field] = codegen.HashSignature(signature)
+		}
+	}
+	return info
+}
+
 // noRetryString generates a string of the form "i_1, i_2, ... i_n" where the
 // individual elements are the indices of methods in comp.retry that should not
 // be retried.
@@ -1322,7 +1457,7 @@ func (g *generator) generateLocalStubs(p printFn) {
 			p(`	span := %s(ctx)`, g.trace().qualify("SpanFromContext"))
 			p(`	if span.SpanContext().IsValid() {`)
 			p(`		// Create a child span for this method.`)
-			p(`		ctx, span = s.tracer.Start(ctx, "%s.%s.%s", trace.WithSpanKind(trace.SpanKindInternal))`, g.pkg.Name, comp.intfName(), m.Name())
+			p(`		ctx, span = s.tracer.Start(ctx, "%s.%s.%s", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(%s.String(%q)))`, g.pkg.Name, comp.intfName(), m.Name(), g.codegen().qualify("ComponentTraceKey"), comp.fullIntfName())
 			p(`		defer func() {`)
 			p(`			if err != nil {`)
 			p(`				span.RecordError(err)`)
@@ -1398,7 +1533,7 @@ func (g *generator) generateClientStubs(p printFn) {
 			p(`	span := %s(ctx)`, g.trace().qualify("SpanFromContext"))
 			p(`	if span.SpanContext().IsValid() {`)
 			p(`		// Create a child span for this method.`)
-			p(`		ctx, span = s.stub.Tracer().Start(ctx, "%s.%s.%s", trace.WithSpanKind(trace.SpanKindClient))`, g.pkg.Name, comp.intfName(), m.Name())
+			p(`		ctx, span = s.stub.Tracer().Start(ctx, "%s.%s.%s", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(%s.String(%q)))`, g.pkg.Name, comp.intfName(), m.Name(), g.codegen().qualify("ComponentTraceKey"), comp.fullIntfName())
 			p(`	}`)
 
 			// Handle cleanup.
@@ -1614,6 +1749,9 @@ func (g *generator) isWeaverEncoded(t types.Type) bool {
 			}
 			return true
 		}
+		if g.tset.interfaces.At(x) != nil {
+			return true
+		}
 		return g.isWeaverEncoded(x.Underlying())
 
 	default:
@@ -2049,6 +2187,7 @@ func (g *generator) generateAutoMarshalMethods(p printFn) {
 
 		// Generate WeaverMarshal method.
 		fmt := g.tset.importPackage("fmt", "fmt")
+		tags, _ := fieldTags(s) // tags were checked by newGenerator
 		p(``)
 		p(`func (x *%s) WeaverMarshal(enc *%s) {`, ts(t), g.codegen().qualify("Encoder"))
 		p(`	if x == nil {`)
@@ -2056,10 +2195,24 @@ func (g *generator) generateAutoMarshalMethods(p printFn) {
 		p(`	}`)
 		for i := 0; i < s.NumFields(); i++ {
 			fi := s.Field(i)
-			if !isWeaverAutoMarshal(fi.Type()) {
+			if isWeaverAutoMarshal(fi.Type()) {
+				continue
+			}
+			innerTypes = append(innerTypes, fi.Type())
+			if tags == nil {
 				p(`	%s`, g.encode("enc", "x."+fi.Name(), fi.Type()))
-				innerTypes = append(innerTypes, fi.Type())
+				continue
 			}
+			// Tagged fields are length-prefixed so that decoders can skip
+			// the fields they don't know.
+			p(`	{`)
+			p(`		start := enc.BeginField(%d)`, tags[i])
+			p(`		%s`, g.encode("enc", "x."+fi.Name(), fi.Type()))
+			p(`		enc.EndField(start)`)
+			p(`	}`)
+		}
+		if tags != nil {
+			p(`	enc.EndFields()`)
 		}
 		p(`}`)
 
@@ -2069,11 +2222,30 @@ func (g *generator) generateAutoMarshalMethods(p printFn) {
 		p(`	if x == nil {`)
 		p(`		panic(%s("%s.WeaverUnmarshal: nil receiver"))`, fmt.qualify("Errorf"), ts(t))
 		p(`	}`)
-		for i := 0; i < s.NumFields(); i++ {
-			fi := s.Field(i)
-			if !isWeaverAutoMarshal(fi.Type()) {
-				p(`	%s`, g.decode("dec", "&x."+fi.Name(), fi.Type()))
+		if tags == nil {
+			for i := 0; i < s.NumFields(); i++ {
+				fi := s.Field(i)
+				if !isWeaverAutoMarshal(fi.Type()) {
+					p(`	%s`, g.decode("dec", "&x."+fi.Name(), fi.Type()))
+				}
 			}
+		} else {
+			// Missing fields are left zero, and unknown fields are skipped.
+			p(`	*x = %s{}`, ts(t))
+			p(`	for {`)
+			p(`		tag, field := dec.Field()`)
+			p(`		switch tag {`)
+			p(`		case 0:`)
+			p(`			return`)
+			for i := 0; i < s.NumFields(); i++ {
+				fi := s.Field(i)
+				if !isWeaverAutoMarshal(fi.Type()) {
+					p(`		case %d:`, tags[i])
+					p(`			%s`, g.decode("field", "&x."+fi.Name(), fi.Type()))
+				}
+			}
+			p(`		}`)
+			p(`	}`)
 		}
 		p(`}`)
 
@@ -2083,10 +2255,10 @@ func (g *generator) generateAutoMarshalMethods(p printFn) {
 		}
 
 		// Register the type so it can be sent when the compile time type
-		// is an interface (like error). For now, we only do so for types
-		// that implement error. We could conceivably allow other types to
-		// be sent around as interfaces in the future.
-		if g.tset.implementsError(t) {
+		// is an interface (like error). We only do so for types that
+		// implement error or a named interface type that is serialized in
+		// this package.
+		if g.tset.implementsError(t) || g.tset.implementsSerializedInterface(t) {
 			p("func init() { %s[*%s]() }", g.codegen().qualify("RegisterSerializable"), ts(t))
 		}
 	}
@@ -2193,6 +2365,7 @@ func (g *generator) encode(stub, e string, t types.Type) string {
 	// enc(stub, e: type t u) = stub.EncodeProto(&e)           // t implements proto.Message
 	// enc(stub, e: type t u) = (e).WeaverMarshal(stub)         // t implements AutoMarshal
 	// enc(stub, e: type t u) = stub.EncodeBinaryMarshaler(&e) // t implements BinaryMarshaler
+	// enc(stub, e: type t u) = stub.InterfaceValue(e)          // under(u) = interface{...}
 	// enc(stub, e: type t u) = serviceweaver_enc_[t](&stub, &e)       // under(u) = struct{...}
 	// enc(stub, e: type t u) = enc(&stub, under(t)(e))        // otherwise
 	switch x := t.(type) {
@@ -2234,6 +2407,9 @@ func (g *generator) encode(stub, e string, t types.Type) string {
 		if g.tset.hasMarshalBinary(x) {
 			return fmt.Sprintf("%s.EncodeBinaryMarshaler(%s)", stub, ref(e))
 		}
+		if g.tset.interfaces.At(x) != nil {
+			return fmt.Sprintf("%s.InterfaceValue(%s)", stub, e)
+		}
 		under := x.Underlying()
 		if _, ok := under.(*types.Struct); ok {
 			return fmt.Sprintf("%s(%s, %s)", f(x), stub, ref(e))
@@ -2266,6 +2442,7 @@ func (g *generator) decode(stub, v string, t types.Type) string {
 	// dec(stub, v: type t u) = stub.DecodeProto(v)             // t implements proto.Message
 	// dec(stub, v: type t u) = (v).WeaverUnmarshal(stub)        // t implements AutoMarshal
 	// dec(stub, v: type t u) = stub.DecodeBinaryUnmarshaler(v) // t implements BinaryUnmarshaler
+	// dec(stub, v: type t u) = *v = codegen.DecodeInterfaceValue[t](stub) // under(u) = interface{...}
 	// dec(stub, v: type t u) = serviceweaver_dec_[t](stub, v)          // under(u) = struct{...}
 	// dec(stub, v: type t u) = dec(stub, (*under(t))(v))       // otherwise
 	switch x := t.(type) {
@@ -2307,6 +2484,9 @@ func (g *generator) decode(stub, v string, t types.Type) string {
 		if g.tset.hasMarshalBinary(x) {
 			return fmt.Sprintf("%s.DecodeBinaryUnmarshaler(%s)", stub, v)
 		}
+		if g.tset.interfaces.At(x) != nil {
+			return fmt.Sprintf("%s = %s[%s](%s)", deref(v), g.codegen().qualify("DecodeInterfaceValue"), g.tset.genTypeString(x), stub)
+		}
 		under := x.Underlying()
 		if _, ok := under.(*types.Struct); ok {
 			return fmt.Sprintf("%s(%s, %s)", f(x), stub, v)
@@ -2376,6 +2556,7 @@ func (g *generator) generateEncDecMethodsFor(p printFn, t types.Type) {
 
 		p(``)
 		p(`func serviceweaver_enc_%s(enc *%s, arg %s) {`, sanitize(x), g.codegen().qualify("Encoder"), ts(x))
+		g.beginNested(p, "enc", x)
 		p(`	if arg == nil {`)
 		p(`		enc.Bool(false)`)
 		p(`	} else {`)
@@ -2386,6 +2567,7 @@ func (g *generator) generateEncDecMethodsFor(p printFn, t types.Type) {
 
 		p(``)
 		p(`func serviceweaver_dec_%s(dec *%s) %s {`, sanitize(x), g.codegen().qualify("Decoder"), ts(x))
+		g.beginNested(p, "dec", x)
 		p(`	if !dec.Bool() {`)
 		p(`		return nil`)
 		p(`	}`)
@@ -2418,6 +2600,7 @@ func (g *generator) generateEncDecMethodsFor(p printFn, t types.Type) {
 
 		p(``)
 		p(`func serviceweaver_enc_%s(enc *%s, arg %s) {`, sanitize(x), g.codegen().qualify("Encoder"), ts(x))
+		g.beginNested(p, "enc", x)
 		p(`	if arg == nil {`)
 		p(`		enc.Len(-1)`)
 		p(`		return`)
@@ -2430,6 +2613,7 @@ func (g *generator) generateEncDecMethodsFor(p printFn, t types.Type) {
 
 		p(``)
 		p(`func serviceweaver_dec_%s(dec *%s) %s {`, sanitize(x), g.codegen().qualify("Decoder"), ts(x))
+		g.beginNested(p, "dec", x)
 		p(`	n := dec.Len()`)
 		p(`	if n == -1 {`)
 		p(`		return nil`)
@@ -2447,6 +2631,7 @@ func (g *generator) generateEncDecMethodsFor(p printFn, t types.Type) {
 
 		p(``)
 		p(`func serviceweaver_enc_%s(enc *%s, arg %s) {`, sanitize(x), g.codegen().qualify("Encoder"), ts(x))
+		g.beginNested(p, "enc", x)
 		p(`	if arg == nil {`)
 		p(`		enc.Len(-1)`)
 		p(`		return`)
@@ -2460,6 +2645,7 @@ func (g *generator) generateEncDecMethodsFor(p printFn, t types.Type) {
 
 		p(``)
 		p(`func serviceweaver_dec_%s(dec *%s) %s {`, sanitize(x), g.codegen().qualify("Decoder"), ts(x))
+		g.beginNested(p, "dec", x)
 		p(`	n := dec.Len()`)
 		p(`	if n == -1 {`)
 		p(`		return nil`)
@@ -2480,12 +2666,13 @@ func (g *generator) generateEncDecMethodsFor(p printFn, t types.Type) {
 		panic(fmt.Sprintf("generateEncDecFor: unexpected type: %v", t))
 
 	case *types.Named:
-		if g.tset.isProto(x) || g.tset.automarshals.At(x) != nil || g.tset.implementsAutoMarshal(x) || g.tset.hasMarshalBinary(x) {
+		if g.tset.isProto(x) || g.tset.automarshals.At(x) != nil || g.tset.implementsAutoMarshal(x) || g.tset.hasMarshalBinary(x) || g.tset.interfaces.At(x) != nil {
 			// Types implementing proto.Marshal, weaver.AutoMarshal, or
-			// encoding.BinaryMarshaler and encoding.BinaryUnmarshaler don't
-			// need encoding or decoding methods. Instead, we call methods
-			// directly on a codegen.Encoder or codegen.Decoder (e.g.,
-			// enc.EncodeProto(x), dec.DecodeBinaryUnmarshaler(x)).
+			// encoding.BinaryMarshaler and encoding.BinaryUnmarshaler, and
+			// named interface types, don't need encoding or decoding
+			// methods. Instead, we call methods directly on a
+			// codegen.Encoder or codegen.Decoder (e.g., enc.EncodeProto(x),
+			// dec.DecodeBinaryUnmarshaler(x)).
 			return
 		}
 		// If a named type t is not a struct, e.g. `type t int`, then we
@@ -2498,6 +2685,19 @@ func (g *generator) generateEncDecMethodsFor(p printFn, t types.Type) {
 	}
 }
 
+// beginNested prints, using p, the statements that track the nesting depth
+// of stub (of type *codegen.Encoder or *codegen.Decoder) in the encoding or
+// decoding function for the provided type, if the type is recursive. Every
+// cycle in a recursive type includes a pointer, slice, or map type, so the
+// nesting depth of every recursive value is tracked.
+func (g *generator) beginNested(p printFn, stub string, t types.Type) {
+	if !g.tset.isRecursive(t) {
+		return
+	}
+	p(`	%s.BeginNested()`, stub)
+	p(`	defer %s.EndNested()`, stub)
+}
+
 // weaver imports and returns the weaver package.
 func (g *generator) weaver() importPkg {
 	return g.tset.importPackage(weaverPackagePath, "weaver")
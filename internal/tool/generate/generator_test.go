@@ -100,9 +100,11 @@ func runGenerator(t *testing.T, directory, filename, contents string, subdirs []
 		t.Fatalf("go mod tidy: %v", err)
 	}
 
-	// Run "weaver generate".
+	// Run "weaver generate". Mocks are generated as well, so that the
+	// "go build" below checks that they compile.
 	opt := Options{
-		Warn: func(err error) { t.Log(err) },
+		Warn:  func(err error) { t.Log(err) },
+		Mocks: true,
 	}
 	if err := Generate(tmp, []string{tmp}, opt); err != nil {
 		return "", err
@@ -122,8 +124,8 @@ func runGenerator(t *testing.T, directory, filename, contents string, subdirs []
 		}
 	}
 
-	// Run "go mod tidy" and "go build" to make sure that weaver_gen.go
-	// compiles.
+	// Run "go mod tidy" and "go build" to make sure that weaver_gen.go and
+	// weaver_gen_mock.go compile.
 	//
 	// TODO(mwhittaker): Instead of running external commands, can we invoke
 	// these using a builtin library?
@@ -459,7 +461,7 @@ func TestExampleVersion(t *testing.T) {
 	got := fmt.Sprintf("%x", h.Sum(nil))
 
 	// If weaver_gen.go has changed, the codegen version may need updating.
-	const want = "872c34c2f9a62ebcb5085494bbdc92e127d1188b5e171e4f95a8d2f87518c5d6"
+	const want = "5f20704766b413929fa605aead5b80f9aae076525aff46f77e9c15f5ea23f46c"
 	if got != want {
 		t.Fatalf(`Unexpected SHA-256 hash of examples/weaver_gen.go: got %s, want %s. If this change is meaningful, REMEMBER TO UPDATE THE CODEGEN VERSION in runtime/version/version.go.`, got, want)
 	}
@@ -0,0 +1,271 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/types"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ServiceWeaver/weaver/internal/files"
+)
+
+// generatedMockFile is the name of the file that holds generated mocks. The
+// file is excluded from builds with the ignoreWeaverMocks tag, so that mocks
+// can be left out of production binaries.
+const generatedMockFile = "weaver_gen_mock.go"
+
+// isGeneratedFile returns whether the provided file was generated by "weaver
+// generate".
+func isGeneratedFile(filename string) bool {
+	base := filepath.Base(filename)
+	return base == generatedCodeFile || base == generatedMockFile
+}
+
+// generateMocks generates a weaver_gen_mock.go file containing a mock
+// implementation of every component interface in the package. For a component
+// interface Foo with method Bar, the generated MockFoo type has the following
+// methods:
+//
+//   - Bar, which implements Foo.Bar;
+//   - ExpectBar, which queues a handler for a single future call to Bar;
+//   - StubBar, which sets the handler for calls to Bar that are not expected;
+//   - BarCalls, which returns the arguments of every call to Bar; and
+//   - Verify, which reports unmet expectations and unexpected calls.
+//
+// A mock can be passed to weavertest.Fake to replace a component in a test.
+func (g *generator) generateMocks() error {
+	// The mocks are generated with their own typeSet, so that the imports
+	// they need are tracked separately from the imports of weaver_gen.go.
+	m := &generator{
+		pkg:        g.pkg,
+		tset:       newTypeSet(g.pkg, g.tset.automarshals, g.tset.automarshalCandidates),
+		fileset:    g.fileset,
+		components: g.components,
+	}
+
+	var body bytes.Buffer
+	fn := func(format string, args ...interface{}) {
+		fmt.Fprintln(&body, fmt.Sprintf(format, args...))
+	}
+	n := 0
+	for _, comp := range m.components {
+		if comp.isMain {
+			continue
+		}
+		if err := m.generateMock(fn, comp); err != nil {
+			return err
+		}
+		n++
+	}
+	if n == 0 {
+		// There's nothing to generate.
+		return g.removeMocks()
+	}
+
+	var header bytes.Buffer
+	m.generateImports(func(format string, args ...interface{}) {
+		fmt.Fprintln(&header, fmt.Sprintf(format, args...))
+	}, "!ignoreWeaverGen && !ignoreWeaverMocks")
+
+	dst := files.NewWriter(filepath.Join(g.pkgDir(), generatedMockFile))
+	defer dst.Cleanup()
+	for _, buf := range []bytes.Buffer{header, body} {
+		formatted, err := format.Source(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("format.Source: %w", err)
+		}
+		if _, err := io.Copy(dst, bytes.NewReader(formatted)); err != nil {
+			return err
+		}
+	}
+	return dst.Close()
+}
+
+// removeMocks removes the package's weaver_gen_mock.go file, if it was
+// previously generated, so that stale mocks don't outlive the components they
+// mock.
+func (g *generator) removeMocks() error {
+	if len(g.pkg.Syntax) == 0 {
+		return nil
+	}
+	filename := filepath.Join(g.pkgDir(), generatedMockFile)
+	contents, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(contents, []byte(generatedHeader)) {
+		// The file wasn't generated by "weaver generate". Leave it alone.
+		return nil
+	}
+	return os.Remove(filename)
+}
+
+// generateMock generates the mock implementation of the provided component.
+func (g *generator) generateMock(p printFn, comp *component) error {
+	mock := "Mock" + comp.intfName()
+	methods := comp.methods()
+
+	// Check that the generated helper methods don't clash with the component
+	// interface's methods.
+	names := map[string]bool{}
+	for _, m := range methods {
+		names[m.Name()] = true
+	}
+	for _, m := range methods {
+		for _, helper := range []string{"Expect" + m.Name(), "Stub" + m.Name(), m.Name() + "Calls", "Verify"} {
+			if names[helper] {
+				return errorf(g.fileset, comp.intf.Obj().Pos(),
+					"cannot generate mock for component %s: method %s clashes with a generated mock method",
+					comp.intfName(), helper)
+			}
+		}
+	}
+
+	sync := g.tset.importPackage("sync", "sync")
+	fmtPkg := g.tset.importPackage("fmt", "fmt")
+	errorsPkg := g.errorsPackage()
+	if len(methods) > 0 {
+		g.tset.importPackage("context", "context")
+	}
+
+	// fnType returns the type of a handler for the provided method.
+	fnType := func(mt *types.Signature) string {
+		return fmt.Sprintf("func(%s) (%s)", g.args(mt), g.returns(mt))
+	}
+
+	p(``)
+	p(`// %s is a mock implementation of the %s component interface.`, mock, comp.intfName())
+	p(`// Use the Expect and Stub methods to specify how calls are handled, and pass`)
+	p(`// the mock to weavertest.Fake to replace the component in a test.`)
+	p(`type %s struct {`, mock)
+	p(`	mu %s`, sync.qualify("Mutex"))
+	for _, m := range methods {
+		mt := m.Type().(*types.Signature)
+		name := notExported(m.Name())
+		p(`	%sExpected []%s`, name, fnType(mt))
+		p(`	%sStub %s`, name, fnType(mt))
+		p(`	%sCalls []%s%sCall`, name, mock, m.Name())
+	}
+	p(`	unexpected []string`)
+	p(`}`)
+	p(``)
+	p(`// Check that %s implements the %s interface.`, mock, comp.intfName())
+	p(`var _ %s = (*%s)(nil)`, g.componentRef(comp), mock)
+
+	for _, m := range methods {
+		mt := m.Type().(*types.Signature)
+		name := notExported(m.Name())
+		call := mock + m.Name() + "Call"
+
+		// The recorded call.
+		p(``)
+		p(`// %s records the arguments of a call to %s.%s.`, call, comp.intfName(), m.Name())
+		p(`type %s struct {`, call)
+		for i := 1; i < mt.Params().Len(); i++ {
+			p(`	A%d %s`, i-1, g.tset.genTypeString(mt.Params().At(i).Type()))
+		}
+		p(`}`)
+
+		// Expect and Stub.
+		p(``)
+		p(`// Expect%s arranges for the next call to %s that is not handled by an`, m.Name(), m.Name())
+		p(`// earlier expectation to be handled by fn.`)
+		p(`func (m *%s) Expect%s(fn %s) {`, mock, m.Name(), fnType(mt))
+		p(`	m.mu.Lock()`)
+		p(`	defer m.mu.Unlock()`)
+		p(`	m.%sExpected = append(m.%sExpected, fn)`, name, name)
+		p(`}`)
+		p(``)
+		p(`// Stub%s arranges for calls to %s without a pending expectation to be`, m.Name(), m.Name())
+		p(`// handled by fn.`)
+		p(`func (m *%s) Stub%s(fn %s) {`, mock, m.Name(), fnType(mt))
+		p(`	m.mu.Lock()`)
+		p(`	defer m.mu.Unlock()`)
+		p(`	m.%sStub = fn`, name)
+		p(`}`)
+
+		// Calls.
+		p(``)
+		p(`// %sCalls returns the calls made to %s so far, in order.`, m.Name(), m.Name())
+		p(`func (m *%s) %sCalls() []%s {`, mock, m.Name(), call)
+		p(`	m.mu.Lock()`)
+		p(`	defer m.mu.Unlock()`)
+		p(`	return append([]%s(nil), m.%sCalls...)`, call, name)
+		p(`}`)
+
+		// The method itself.
+		var fields, args strings.Builder
+		args.WriteString("ctx")
+		for i := 1; i < mt.Params().Len(); i++ {
+			fmt.Fprintf(&fields, "A%d: a%d, ", i-1, i-1)
+			if mt.Variadic() && i == mt.Params().Len()-1 {
+				fmt.Fprintf(&args, ", a%d...", i-1)
+			} else {
+				fmt.Fprintf(&args, ", a%d", i-1)
+			}
+		}
+		p(``)
+		p(`// %s implements the %s interface.`, m.Name(), comp.intfName())
+		p(`func (m *%s) %s(%s) (%s) {`, mock, m.Name(), g.args(mt), g.returns(mt))
+		p(`	m.mu.Lock()`)
+		p(`	m.%sCalls = append(m.%sCalls, %s{%s})`, name, name, call, strings.TrimSuffix(fields.String(), ", "))
+		p(`	fn := m.%sStub`, name)
+		p(`	if len(m.%sExpected) > 0 {`, name)
+		p(`		fn = m.%sExpected[0]`, name)
+		p(`		m.%sExpected = m.%sExpected[1:]`, name, name)
+		p(`	}`)
+		p(`	if fn == nil {`)
+		p(`		m.unexpected = append(m.unexpected, %q)`, m.Name())
+		p(`	}`)
+		p(`	m.mu.Unlock()`)
+		p(``)
+		p(`	if fn == nil {`)
+		p(`		err = %s("%s: unexpected call to %s")`, errorsPkg.qualify("New"), mock, m.Name())
+		p(`		return`)
+		p(`	}`)
+		p(`	return fn(%s)`, args.String())
+		p(`}`)
+	}
+
+	// Verify.
+	p(``)
+	p(`// Verify returns an error if an expected call was not made, or if a call`)
+	p(`// was made that was neither expected nor stubbed.`)
+	p(`func (m *%s) Verify() error {`, mock)
+	p(`	m.mu.Lock()`)
+	p(`	defer m.mu.Unlock()`)
+	p(`	var errs []error`)
+	for _, m := range methods {
+		name := notExported(m.Name())
+		p(`	if n := len(m.%sExpected); n > 0 {`, name)
+		p(`		errs = append(errs, %s("%s: %%d expected call(s) to %s not made", n))`, fmtPkg.qualify("Errorf"), mock, m.Name())
+		p(`	}`)
+	}
+	p(`	for _, method := range m.unexpected {`)
+	p(`		errs = append(errs, %s("%s: unexpected call to %%s", method))`, fmtPkg.qualify("Errorf"), mock)
+	p(`	}`)
+	p(`	return %s(errs...)`, errorsPkg.qualify("Join"))
+	p(`}`)
+	return nil
+}
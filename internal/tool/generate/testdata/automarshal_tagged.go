@@ -0,0 +1,53 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// EXPECTED
+// start := enc.BeginField(1)
+// start := enc.BeginField(3)
+// enc.EndFields()
+// tag, field := dec.Field()
+// case 3:
+
+// UNEXPECTED
+// size += 8
+
+// Verify that AutoMarshal structs with weaver tags use tagged encoding, and
+// that they are not treated as fixed size.
+package foo
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver"
+)
+
+type pair struct {
+	weaver.AutoMarshal
+	x int    `weaver:"1"`
+	y string `weaver:"3"`
+}
+
+type point struct {
+	weaver.AutoMarshal
+	x int `weaver:"1"`
+	y int `weaver:"2"`
+}
+
+type foo interface {
+	M(context.Context, pair, []point) error
+}
+
+type impl struct{ weaver.Implements[foo] }
+
+func (impl) M(context.Context, pair, []point) error { return nil }
@@ -12,18 +12,13 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// ERROR: A is not serializable
-
+// ERROR: have the same weaver tag 1
 package foo
 
 import "github.com/ServiceWeaver/weaver"
 
-type A struct {
-	weaver.AutoMarshal
-	*B
-}
-
-type B struct {
+type pair struct {
 	weaver.AutoMarshal
-	*A
+	x int `weaver:"1"`
+	y int `weaver:"1"`
 }
@@ -0,0 +1,46 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// ERROR: argument 1 cannot be sent as JSON
+
+// Gateway methods can't take interface arguments, since JSON can't be decoded
+// into an interface.
+package foo
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver"
+)
+
+type shape interface {
+	Area() float64
+}
+
+type circle struct {
+	weaver.AutoMarshal
+	Radius float64
+}
+
+func (c circle) Area() float64 { return 3 * c.Radius * c.Radius }
+
+type Foo interface {
+	Largest(context.Context, []shape) (float64, error)
+}
+
+type foo struct{ weaver.Implements[Foo] }
+
+func (*foo) Largest(context.Context, []shape) (float64, error) { return 0, nil }
+
+var _ weaver.Gateway = Foo(nil)
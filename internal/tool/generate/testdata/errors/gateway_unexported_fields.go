@@ -0,0 +1,40 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// ERROR: has no exported fields
+
+// Gateway methods can't return structs whose fields are all unexported, since
+// they would be sent as {}.
+package foo
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver"
+)
+
+type point struct {
+	weaver.AutoMarshal
+	x, y int
+}
+
+type Foo interface {
+	Origin(context.Context) (point, error)
+}
+
+type foo struct{ weaver.Implements[Foo] }
+
+func (*foo) Origin(context.Context) (point, error) { return point{}, nil }
+
+var _ weaver.Gateway = Foo(nil)
@@ -0,0 +1,37 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// ERROR: serialization of interfaces not currently supported
+
+// Values of a named interface type cannot be serialized if no AutoMarshal type
+// in the package implements it.
+package foo
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver"
+)
+
+type shape interface {
+	Area() float64
+}
+
+type foo interface {
+	M(context.Context, shape) error
+}
+
+type impl struct{ weaver.Implements[foo] }
+
+func (impl) M(context.Context, shape) error { return nil }
@@ -0,0 +1,72 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// EXPECTED
+// func NewFooGateway(c Foo) http.Handler {
+// mux.HandleFunc("/Foo/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+// codegen.WriteGatewayOpenAPI(w, r, foo_openapi)
+// mux.HandleFunc("/Foo/Get", func(w http.ResponseWriter, r *http.Request) {
+// var a0 string
+// if !codegen.ReadGatewayArgs(w, r, &a0) {
+// r0, err := c.Get(r.Context(), a0)
+// codegen.WriteGatewayResults(w, err, r0)
+// mux.HandleFunc("/Foo/Sum", func(w http.ResponseWriter, r *http.Request) {
+// var a0 []int
+// r0, err := c.Sum(r.Context(), a0...)
+// const foo_openapi = `{
+// "openapi": "3.1.0",
+// "/Foo/Get": {
+// "$ref": "#/components/schemas/foo.Pair"
+// "format": "date-time",
+
+// UNEXPECTED
+// func NewBarGateway
+
+// Package foo contains components with and without HTTP/JSON gateways.
+package foo
+
+import (
+	"context"
+	"time"
+
+	"github.com/ServiceWeaver/weaver"
+)
+
+type Pair struct {
+	weaver.AutoMarshal
+	Key   string
+	Value int `json:"value"`
+	When  time.Time
+	Next  *Pair
+}
+
+type Foo interface {
+	Get(context.Context, string) (Pair, error)
+	Sum(context.Context, ...int) (int, error)
+}
+
+type Bar interface {
+	Ping(context.Context) error
+}
+
+type foo struct{ weaver.Implements[Foo] }
+
+func (*foo) Get(context.Context, string) (Pair, error) { return Pair{}, nil }
+func (*foo) Sum(context.Context, ...int) (int, error)  { return 0, nil }
+
+type bar struct{ weaver.Implements[Bar] }
+
+func (*bar) Ping(context.Context) error { return nil }
+
+var _ weaver.Gateway = Foo(nil)
@@ -0,0 +1,66 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// EXPECTED
+// enc.InterfaceValue(a0)
+// codegen.DecodeInterfaceValue[shape](dec)
+// codegen.RegisterSerializable[*circle]()
+// codegen.RegisterSerializable[*square]()
+
+// UNEXPECTED
+// func serviceweaver_enc_shape
+// codegen.RegisterSerializable[*other]()
+
+// Verify that values of named interface types are serialized through the
+// implementations registered for the interface.
+package foo
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver"
+)
+
+type shape interface {
+	Area() float64
+}
+
+type circle struct {
+	weaver.AutoMarshal
+	radius float64
+}
+
+func (c circle) Area() float64 { return 3.14 * c.radius * c.radius }
+
+type square struct {
+	weaver.AutoMarshal
+	side float64
+}
+
+func (s *square) Area() float64 { return s.side * s.side }
+
+type other struct {
+	weaver.AutoMarshal
+	x int
+}
+
+type foo interface {
+	M(context.Context, shape, []shape) (shape, error)
+	N(context.Context, other) error
+}
+
+type impl struct{ weaver.Implements[foo] }
+
+func (impl) M(context.Context, shape, []shape) (shape, error) { return nil, nil }
+func (impl) N(context.Context, other) error                   { return nil }
@@ -0,0 +1,62 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// EXPECTED
+// func serviceweaver_enc_ptr_tree
+// func serviceweaver_enc_slice_ptr_tree
+// func serviceweaver_dec_map_string_tree
+// enc.BeginNested()
+// defer enc.EndNested()
+// dec.BeginNested()
+// defer dec.EndNested()
+
+// UNEXPECTED
+// serviceweaver_size_tree
+// serviceweaver_size_ptr_tree
+
+// Verify that recursive types are serializable.
+package foo
+
+import (
+	"context"
+
+	"github.com/ServiceWeaver/weaver"
+)
+
+type tree struct {
+	weaver.AutoMarshal
+	value    int
+	children []*tree
+	parent   *tree
+}
+
+type forest map[string]forest
+
+type A struct {
+	weaver.AutoMarshal
+	*B
+}
+
+type B struct {
+	weaver.AutoMarshal
+	*A
+}
+
+type foo interface {
+	M(context.Context, *tree, forest, A) (map[string]tree, error)
+}
+
+type impl struct{ weaver.Implements[foo] }
+
+func (impl) M(context.Context, *tree, forest, A) (map[string]tree, error) { return nil, nil }
@@ -15,10 +15,13 @@
 package generate
 
 import (
+	"errors"
 	"fmt"
 	"go/types"
 	"path"
+	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"golang.org/x/tools/go/packages"
@@ -35,6 +38,7 @@ type typeSet struct {
 	importedByName map[string]importPkg // imported, indexed by name
 
 	automarshals          *typeutil.Map // types that implement AutoMarshal
+	interfaces            typeutil.Map  // named interface types that are serialized
 	automarshalCandidates *typeutil.Map // types that declare themselves AutoMarshal
 
 	// If checked[t] != nil, then checked[t] is the cached result of calling
@@ -48,6 +52,9 @@ type typeSet struct {
 
 	// If measurable[t] != nil, then measurable[t] == isMeasurableType(t).
 	measurable typeutil.Map
+
+	// If recursive[t] != nil, then recursive[t] == isRecursive(t).
+	recursive typeutil.Map
 }
 
 // importPkg is a package imported by the generated code.
@@ -203,7 +210,7 @@ func (tset *typeSet) checkSerializable(t types.Type) []error {
 	}
 
 	// stack contains the set of types encountered in the call stack of check.
-	// It's used to detect recursive types.
+	// It's used to handle recursive types.
 	//
 	// More specifically, the check function below is performing an implicit
 	// depth first search of the graph of types formed by t. We record the
@@ -227,8 +234,9 @@ func (tset *typeSet) checkSerializable(t types.Type) []error {
 	//
 	// When performing the second check(A) call, stack includes A, struct { b:
 	// *B }, *B, B, struct { a: *A }, and *A. Because we called check on A and
-	// A is already in stack, we detect a recursive type and mark A as not
-	// serializable.
+	// A is already in stack, we detect a recursive type. The second check(A)
+	// call assumes that A is serializable, since whether A is serializable is
+	// decided by the first check(A) call.
 	var stack typeutil.Map
 
 	// check recursively checks whether a type t is serializable. See lineage
@@ -277,9 +285,7 @@ func (tset *typeSet) checkSerializable(t types.Type) []error {
 
 		// Check for recursive types.
 		if stack.At(t) != nil {
-			addError(fmt.Errorf("serialization of recursive types not currently supported"))
-			tset.checked.Set(t, false)
-			return false
+			return true
 		}
 		stack.Set(t, struct{}{})
 		defer func() { stack.Delete(t) }()
@@ -295,6 +301,22 @@ func (tset *typeSet) checkSerializable(t types.Type) []error {
 				break
 			}
 
+			// Values of named interface types are serialized along with
+			// their concrete types, which must be registered AutoMarshal
+			// types (see codegen.RegisterSerializable). Only interfaces
+			// declared in this package with at least one AutoMarshal
+			// implementation in this package are serializable.
+			if _, ok := x.Underlying().(*types.Interface); ok && !isError(x) {
+				if !tset.hasSerializableImplementation(x) {
+					addError(fmt.Errorf("serialization of interfaces not currently supported"))
+					tset.checked.Set(t, false)
+					break
+				}
+				tset.interfaces.Set(t, struct{}{})
+				tset.checked.Set(t, true)
+				break
+			}
+
 			// If the underlying type is not a struct, then we simply recurse
 			// on the underlying type.
 			s, ok := x.Underlying().(*types.Struct)
@@ -329,8 +351,8 @@ func (tset *typeSet) checkSerializable(t types.Type) []error {
 			tset.checked.Set(t, serializable)
 
 		case *types.Interface:
-			// TODO(sanjay): Support types.Interface only if we can figure out
-			// a way to instantiate the type.
+			// Only named interface types are serializable, since the concrete
+			// types of their values are registered in generated code.
 			addError(fmt.Errorf("serialization of interfaces not currently supported"))
 			tset.checked.Set(t, false)
 
@@ -438,6 +460,12 @@ func (tset *typeSet) sizeOfType(t types.Type) int {
 		return size
 
 	case *types.Struct:
+		if isTagged(x) {
+			// Tagged fields are length-prefixed, so the size of a tagged
+			// struct isn't the sum of the sizes of its fields.
+			tset.sizes.Set(t, -1)
+			return -1
+		}
 		size := 0
 		for i := 0; i < x.NumFields(); i++ {
 			n := tset.sizeOfType(x.Field(i).Type())
@@ -491,6 +519,7 @@ func (tset *typeSet) isMeasurable(t types.Type) bool {
 	//     m(struct{..., fi:ti, ...}) = true, if every ti is measurable.
 	//     m(weaver.AutoMarshal) = true
 	//     m(type t u) = m(u), if t is package local
+	//     m(t) = false, if t is recursive
 	//     m(_) = false
 	if result := tset.measurable.At(t); result != nil {
 		return result.(bool)
@@ -512,6 +541,11 @@ func (tset *typeSet) isMeasurable(t types.Type) bool {
 		}
 
 	case *types.Pointer:
+		// Recursive types are not measurable. We mark t as not measurable
+		// while we recurse on t, in case we run into t again. Every cycle in a
+		// recursive type includes a pointer, since cycles through slices and
+		// maps stop at isFixedSizeType.
+		tset.measurable.Set(t, false)
 		tset.measurable.Set(t, tset.isMeasurable(x.Elem()))
 
 	case *types.Array:
@@ -524,7 +558,7 @@ func (tset *typeSet) isMeasurable(t types.Type) bool {
 		tset.measurable.Set(t, tset.isFixedSizeType(x.Key()) && tset.isFixedSizeType(x.Elem()))
 
 	case *types.Struct:
-		measurable := true
+		measurable := !isTagged(x)
 		for i := 0; i < x.NumFields() && measurable; i++ {
 			f := x.Field(i)
 			if f.Pkg() != rootPkg {
@@ -551,6 +585,202 @@ func (tset *typeSet) isMeasurable(t types.Type) bool {
 	return tset.measurable.At(t).(bool)
 }
 
+// isRecursive returns whether the provided type contains itself. For example,
+// given the following types, T, []*T, *T, and U are recursive, but []U is not.
+//
+//	type T struct { children []*T }
+//	type U []U
+//
+// Types whose values are serialized by their own methods (e.g., protos and
+// types implementing encoding.BinaryMarshaler) and interface types don't
+// contain any types.
+func (tset *typeSet) isRecursive(t types.Type) bool {
+	if result := tset.recursive.At(t); result != nil {
+		return result.(bool)
+	}
+
+	var visited typeutil.Map
+	var reaches func(u types.Type) bool
+	reaches = func(u types.Type) bool {
+		if visited.At(u) != nil {
+			return false
+		}
+		visited.Set(u, true)
+
+		var nested []types.Type
+		switch x := u.(type) {
+		case *types.Pointer:
+			nested = []types.Type{x.Elem()}
+		case *types.Array:
+			nested = []types.Type{x.Elem()}
+		case *types.Slice:
+			nested = []types.Type{x.Elem()}
+		case *types.Map:
+			nested = []types.Type{x.Key(), x.Elem()}
+		case *types.Struct:
+			for i := 0; i < x.NumFields(); i++ {
+				nested = append(nested, x.Field(i).Type())
+			}
+		case *types.Named:
+			if !tset.isProto(x) && !tset.hasMarshalBinary(x) {
+				nested = []types.Type{x.Underlying()}
+			}
+		}
+		for _, n := range nested {
+			if types.Identical(n, t) || reaches(n) {
+				return true
+			}
+		}
+		return false
+	}
+	recursive := reaches(t)
+	tset.recursive.Set(t, recursive)
+	return recursive
+}
+
+// signature returns a string that describes how values of the provided type
+// are serialized. Values of types with the same signature are serialized the
+// same way. For example, the signature of the following type A is
+// "struct{int;*string;}", since field names aren't serialized.
+//
+//	type A struct {
+//	    weaver.AutoMarshal
+//	    x int
+//	    y *string
+//	}
+//
+// Types that are serialized by their own methods (e.g., protos and types
+// implementing encoding.BinaryMarshaler) and interface types are described by
+// their names. Structs that use tagged encoding are described by their names
+// and by the tag and signature of every field, in tag order, since fields are
+// serialized by tag rather than by position.
+//
+// REQUIRES: t is serializable.
+func (tset *typeSet) signature(t types.Type) string {
+	return tset.describe(t, nil)
+}
+
+// taggedSignature is like signature, except that structs that use tagged
+// encoding are described only by their names, e.g., tagged(foo.Pair). The
+// signature of every field of these structs is stored in fields instead,
+// keyed by "<struct name>#<tag>", e.g., "foo.Pair#1". This allows two
+// versions of a tagged struct to be compared field by field, since a field
+// that is present in only one version doesn't change how the other fields
+// are serialized.
+//
+// REQUIRES: t is serializable.
+func (tset *typeSet) taggedSignature(t types.Type, fields map[string]string) string {
+	return tset.describe(t, fields)
+}
+
+// describe implements signature and taggedSignature. If fields is nil, the
+// fields of tagged structs are described inline.
+func (tset *typeSet) describe(t types.Type, fields map[string]string) string {
+	qualifier := func(pkg *types.Package) string { return pkg.Path() }
+	var b strings.Builder
+	var stack typeutil.Map // named types being described, to handle recursive types
+	var f func(t types.Type)
+	f = func(t types.Type) {
+		if tset.isProto(t) {
+			fmt.Fprintf(&b, "proto(%s)", types.TypeString(t, qualifier))
+			return
+		}
+		switch x := t.(type) {
+		case *types.Basic:
+			b.WriteString(x.Name())
+
+		case *types.Pointer:
+			b.WriteString("*")
+			f(x.Elem())
+
+		case *types.Array:
+			fmt.Fprintf(&b, "[%d]", x.Len())
+			f(x.Elem())
+
+		case *types.Slice:
+			b.WriteString("[]")
+			f(x.Elem())
+
+		case *types.Map:
+			b.WriteString("map[")
+			f(x.Key())
+			b.WriteString("]")
+			f(x.Elem())
+
+		case *types.Struct:
+			b.WriteString("struct{")
+			for i := 0; i < x.NumFields(); i++ {
+				if isWeaverAutoMarshal(x.Field(i).Type()) {
+					continue
+				}
+				f(x.Field(i).Type())
+				b.WriteString(";")
+			}
+			b.WriteString("}")
+
+		case *types.Named:
+			name := types.TypeString(x, qualifier)
+			s, isStruct := x.Underlying().(*types.Struct)
+			switch {
+			case tset.hasMarshalBinary(x):
+				fmt.Fprintf(&b, "binary(%s)", name)
+			case tset.interfaces.At(x) != nil:
+				fmt.Fprintf(&b, "interface(%s)", name)
+			case stack.At(x) != nil:
+				fmt.Fprintf(&b, "rec(%s)", name)
+			case isStruct && isTagged(s) && fields != nil:
+				// E.g., tagged(foo.Pair), with fields foo.Pair#1 and
+				// foo.Pair#2 stored in fields.
+				fmt.Fprintf(&b, "tagged(%s)", name)
+				tags, _ := fieldTags(s) // t is serializable, so the tags are valid
+				for i := 0; i < s.NumFields(); i++ {
+					if isWeaverAutoMarshal(s.Field(i).Type()) {
+						continue
+					}
+					key := fmt.Sprintf("%s#%d", name, tags[i])
+					if _, ok := fields[key]; ok {
+						// Already described, or being described by a
+						// recursive call.
+						continue
+					}
+					fields[key] = ""
+					fields[key] = tset.describe(s.Field(i).Type(), fields)
+				}
+			case isStruct && isTagged(s):
+				// E.g., tagged(foo.Pair){1:int;2:string;}
+				fmt.Fprintf(&b, "tagged(%s){", name)
+				tags, _ := fieldTags(s) // t is serializable, so the tags are valid
+				fields := make([]int, 0, s.NumFields())
+				for i := 0; i < s.NumFields(); i++ {
+					if !isWeaverAutoMarshal(s.Field(i).Type()) {
+						fields = append(fields, i)
+					}
+				}
+				sort.Slice(fields, func(i, j int) bool { return tags[fields[i]] < tags[fields[j]] })
+				stack.Set(x, true)
+				for _, i := range fields {
+					fmt.Fprintf(&b, "%d:", tags[i])
+					f(s.Field(i).Type())
+					b.WriteString(";")
+				}
+				stack.Delete(x)
+				b.WriteString("}")
+			default:
+				stack.Set(x, true)
+				f(x.Underlying())
+				stack.Delete(x)
+			}
+
+		default:
+			// Other types (e.g., channels and functions in unexported
+			// fields of error types) are described by their names.
+			b.WriteString(types.TypeString(t, qualifier))
+		}
+	}
+	f(t)
+	return b.String()
+}
+
 // genTypeString returns the string representation of t as to be printed
 // in the generated code, updating import definitions to account for the
 // returned type string.
@@ -583,6 +813,34 @@ func isInvalid(t types.Type) bool {
 	return t.String() == "invalid type"
 }
 
+// hasSerializableImplementation returns whether the provided named interface
+// type is declared in the package and is implemented by at least one of the
+// package's AutoMarshal types, or by a pointer to one.
+func (tset *typeSet) hasSerializableImplementation(t *types.Named) bool {
+	if t.Obj().Pkg() != tset.pkg.Types {
+		return false
+	}
+	iface := t.Underlying().(*types.Interface)
+	for _, impl := range tset.automarshalCandidates.Keys() {
+		if types.Implements(impl, iface) || types.Implements(types.NewPointer(impl), iface) {
+			return true
+		}
+	}
+	return false
+}
+
+// implementsSerializedInterface returns whether t or *t implements one of the
+// named interface types that are serialized in the package.
+func (tset *typeSet) implementsSerializedInterface(t types.Type) bool {
+	for _, i := range tset.interfaces.Keys() {
+		iface := i.Underlying().(*types.Interface)
+		if types.Implements(t, iface) || types.Implements(types.NewPointer(t), iface) {
+			return true
+		}
+	}
+	return false
+}
+
 // implementsError returns whether the provided type is a concrete type that
 // implements error.
 func (tset *typeSet) implementsError(t types.Type) bool {
@@ -885,14 +1143,81 @@ func isWeaverWithRouter(t types.Type) bool {
 	return isWeaverType(t, "WithRouter", 1)
 }
 
+func isWeaverSingleton(t types.Type) bool {
+	return isWeaverType(t, "Singleton", 0)
+}
+
 func isWeaverAutoMarshal(t types.Type) bool {
 	return isWeaverType(t, "AutoMarshal", 0)
 }
 
+// isTagged returns whether the provided struct uses tagged encoding, i.e.
+// whether any of its fields has a weaver struct tag.
+func isTagged(s *types.Struct) bool {
+	for i := 0; i < s.NumFields(); i++ {
+		if _, ok := reflect.StructTag(s.Tag(i)).Lookup("weaver"); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldTags returns the tags of the fields of a struct that uses tagged
+// encoding, indexed by field, or nil if the struct doesn't use tagged
+// encoding. Every field of a tagged struct, except an embedded
+// weaver.AutoMarshal, must have a unique positive tag. For example:
+//
+//	type Pair struct {
+//	    weaver.AutoMarshal
+//	    X int `weaver:"1"`
+//	    Y int `weaver:"2"`
+//	}
+func fieldTags(s *types.Struct) ([]uint32, error) {
+	if !isTagged(s) {
+		return nil, nil
+	}
+	var errs []error
+	tags := make([]uint32, s.NumFields())
+	fields := map[uint32]string{}
+	for i := 0; i < s.NumFields(); i++ {
+		f := s.Field(i)
+		tag, ok := reflect.StructTag(s.Tag(i)).Lookup("weaver")
+		if isWeaverAutoMarshal(f.Type()) {
+			if ok {
+				errs = append(errs, fmt.Errorf("embedded weaver.AutoMarshal must not have a weaver tag"))
+			}
+			continue
+		}
+		if !ok {
+			errs = append(errs, fmt.Errorf("field %s has no weaver tag; either every field or no field must have a weaver tag", f.Name()))
+			continue
+		}
+		n, err := strconv.ParseUint(tag, 10, 32)
+		if err != nil || n == 0 {
+			errs = append(errs, fmt.Errorf("field %s has invalid weaver tag %q; tags must be positive integers", f.Name(), tag))
+			continue
+		}
+		if other, ok := fields[uint32(n)]; ok {
+			errs = append(errs, fmt.Errorf("fields %s and %s have the same weaver tag %d", other, f.Name(), n))
+			continue
+		}
+		fields[uint32(n)] = f.Name()
+		tags[i] = uint32(n)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
 func isWeaverNotRetriable(t types.Type) bool {
 	return isWeaverType(t, "NotRetriable", 0)
 }
 
+func isWeaverGateway(t types.Type) bool {
+	return isWeaverType(t, "Gateway", 0)
+}
+
 func isString(t types.Type) bool {
 	b, ok := t.(*types.Basic)
 	return ok && b.Kind() == types.String
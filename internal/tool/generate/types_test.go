@@ -21,6 +21,7 @@ import (
 	"go/types"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -80,6 +81,20 @@ func (t *target) UnmarshalBinary([]byte) error { return nil }
 type target struct { next *target }
 func (t *target) MarshalBinary() ([]byte, error) { return nil, nil }
 func (t *target) UnmarshalBinary([]byte) error { return nil }
+`, ""},
+		{"simple recursive", `
+type target *target
+`, ""},
+		{"nested recursive", `
+type target []*target
+`, ""},
+		{"mutually recursive", `
+type A []*B
+type B []*A
+type target A
+`, ""},
+		{"recursive map", `
+type target map[string]target
 `, ""},
 
 		// Non-serializable types:
@@ -105,17 +120,12 @@ type target interface{
 	UnmarshalBinary([]byte) error
 }
 `, "not currently supported"},
-		{"simple recursive", `
-type target *target
-`, "not currently supported"},
-		{"nested recursive", `
-type target []*target
-`, "not currently supported"},
-		{"mutually recursive", `
-type A []*B
-type B []*A
-type target A
+		{"otherpkg interface", `
+import "io"
+
+type target []io.Reader
 `, "not currently supported"},
+		{"unnamed interface", "type target []interface{ M() }", "not currently supported"},
 	} {
 		t.Run(c.label, func(t *testing.T) {
 			tset, target := compile(t, c.contents)
@@ -253,6 +263,11 @@ type target Wrapper[int]
 type A struct { x B; y C }
 type B struct { x int; y C }
 type C struct { x int; y []string }
+type target A`, false},
+		{"Recursive", "type target struct{x int; next *target}", false},
+		{"MutuallyRecursive", `
+type A struct { b *B }
+type B struct { a *A }
 type target A`, false},
 	} {
 		t.Run(c.label, func(t *testing.T) {
@@ -264,6 +279,104 @@ type target A`, false},
 	}
 }
 
+func TestIsRecursive(t *testing.T) {
+	type testCase struct {
+		label    string
+		contents string
+		want     bool
+	}
+	for _, c := range []testCase{
+		{"int", "type target int", false},
+		{"slice", "type target []int", false},
+		{"struct", "type target struct{x int; y []string}", false},
+		{"pointer", "type target *target", true},
+		{"slice", "type target []target", true},
+		{"map", "type target map[string]target", true},
+		{"struct", "type target struct{children []*target}", true},
+		{"mutual", `
+type target struct { b *B }
+type B struct { a []target }`, true},
+		{"contains recursive", `
+type A []A
+type target []A`, false},
+		{"BinaryMarshaler", `
+type target struct { next *target }
+func (t *target) MarshalBinary() ([]byte, error) { return nil, nil }
+func (t *target) UnmarshalBinary([]byte) error { return nil }
+`, false},
+	} {
+		t.Run(c.label, func(t *testing.T) {
+			tset, target := compile(t, c.contents)
+			if got := tset.isRecursive(target); got != c.want {
+				t.Fatalf("isRecursive: got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestTaggedSignature(t *testing.T) {
+	type testCase struct {
+		label    string
+		contents string
+		want     string
+	}
+	for _, c := range []testCase{
+		{"tags", `
+type target struct {
+	Y string ` + "`weaver:\"2\"`" + `
+	X int    ` + "`weaver:\"1\"`" + `
+}`, "tagged(foo.target){1:int;2:string;}"},
+		{"recursive", `
+type target struct {
+	Next *target ` + "`weaver:\"3\"`" + `
+}`, "tagged(foo.target){3:*rec(foo.target);}"},
+	} {
+		t.Run(c.label, func(t *testing.T) {
+			tset, target := compile(t, c.contents)
+			if got := tset.signature(target); got != c.want {
+				t.Fatalf("signature: got %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestTaggedSignatureFields(t *testing.T) {
+	type testCase struct {
+		label      string
+		contents   string
+		wantFields map[string]string
+	}
+	for _, c := range []testCase{
+		{"tags", `
+type target struct {
+	Y string ` + "`weaver:\"2\"`" + `
+	X int    ` + "`weaver:\"1\"`" + `
+}`, map[string]string{"foo.target#1": "int", "foo.target#2": "string"}},
+		{"recursive", `
+type target struct {
+	Next *target ` + "`weaver:\"3\"`" + `
+}`, map[string]string{"foo.target#3": "*tagged(foo.target)"}},
+		{"nested", `
+type inner struct {
+	X int ` + "`weaver:\"1\"`" + `
+}
+type target struct {
+	Inner []inner ` + "`weaver:\"1\"`" + `
+}`, map[string]string{"foo.target#1": "[]tagged(foo.inner)", "foo.inner#1": "int"}},
+	} {
+		t.Run(c.label, func(t *testing.T) {
+			tset, target := compile(t, c.contents)
+			fields := map[string]string{}
+			if got, want := tset.taggedSignature(target, fields), "tagged(foo.target)"; got != want {
+				t.Fatalf("taggedSignature: got %q, want %q", got, want)
+			}
+			if !reflect.DeepEqual(fields, c.wantFields) {
+				t.Fatalf("taggedSignature fields: got %v, want %v", fields, c.wantFields)
+			}
+		})
+	}
+}
+
 func TestIsValidRouterType(t *testing.T) {
 	type testCase struct {
 		label    string
@@ -0,0 +1,237 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package inspect contains code to describe the information embedded in a
+// Service Weaver application binary.
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/ServiceWeaver/weaver/runtime/bin"
+	"github.com/ServiceWeaver/weaver/runtime/graph"
+	"github.com/ServiceWeaver/weaver/runtime/logging"
+)
+
+// Usage is the usage of the "weaver inspect" command.
+const Usage = `Describe an application binary.
+
+Usage:
+  weaver inspect [--format=<format>] <binary>
+
+Flags:
+  -h, --help           Print this help message.
+  --format             Output format: text, json, or dot (default text).
+
+Description:
+  "weaver inspect" prints the information embedded in a Service Weaver
+  application binary: the Service Weaver module and deployer API versions,
+  and for every component, its methods, whether the methods are retried,
+  whether the component is routed, its listeners, and the components it
+  calls.
+
+  The text format is meant for humans. The json format is meant for other
+  tools. The dot format prints the call graph in Graphviz DOT format [1],
+  annotated with methods and listeners; render it with, e.g.,
+  "weaver inspect --format=dot <binary> | dot -Tsvg > app.svg".
+
+[1]: https://graphviz.org/doc/info/lang.html`
+
+// Info describes an application binary.
+type Info struct {
+	ModuleVersion   string      `json:"module_version"`   // Service Weaver module version
+	DeployerVersion string      `json:"deployer_version"` // deployer API version
+	Components      []Component `json:"components"`       // sorted by name
+}
+
+// Component describes a component.
+type Component struct {
+	Name      string   `json:"name"`                // fully qualified component name
+	Routed    bool     `json:"routed"`              // is the component routed?
+	Methods   []Method `json:"methods,omitempty"`   // sorted by name
+	Listeners []string `json:"listeners,omitempty"` // the component's listeners
+	Calls     []string `json:"calls,omitempty"`     // the components it calls
+}
+
+// Method describes a component method.
+type Method struct {
+	Name         string `json:"name"`
+	NotRetriable bool   `json:"not_retriable"` // see weaver.NotRetriable
+}
+
+// Inspect returns a description of the provided application binary.
+func Inspect(filename string) (*Info, error) {
+	versions, err := bin.ReadVersions(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read versions from %q: %w", filename, err)
+	}
+	infos, err := bin.ReadComponents(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read components from %q: %w", filename, err)
+	}
+	names, g, err := bin.ReadComponentGraph(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read call graph from %q: %w", filename, err)
+	}
+	listeners, err := bin.ReadListeners(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read listeners from %q: %w", filename, err)
+	}
+
+	// Binaries generated by older versions of "weaver generate" don't embed
+	// their components' methods, so components are collected from the call
+	// graph and listeners as well.
+	components := map[string]*Component{}
+	get := func(name string) *Component {
+		c, ok := components[name]
+		if !ok {
+			c = &Component{Name: name}
+			components[name] = c
+		}
+		return c
+	}
+	for _, info := range infos {
+		c := get(info.Component)
+		c.Routed = info.Router != ""
+		for _, m := range info.Methods {
+			c.Methods = append(c.Methods, Method{Name: m.Name, NotRetriable: m.NoRetry})
+		}
+	}
+	g.PerNode(func(n graph.Node) { get(names[n]) })
+	graph.PerEdge(g, func(e graph.Edge) {
+		c := get(names[e.Src])
+		c.Calls = append(c.Calls, names[e.Dst])
+	})
+	for _, l := range listeners {
+		c := get(l.Component)
+		c.Listeners = append(c.Listeners, l.Listeners...)
+	}
+
+	info := &Info{
+		ModuleVersion:   versions.ModuleVersion,
+		DeployerVersion: versions.DeployerVersion.String(),
+	}
+	for _, c := range components {
+		sort.Slice(c.Methods, func(i, j int) bool {
+			return c.Methods[i].Name < c.Methods[j].Name
+		})
+		sort.Strings(c.Calls)
+		sort.Strings(c.Listeners)
+		info.Components = append(info.Components, *c)
+	}
+	sort.Slice(info.Components, func(i, j int) bool {
+		return info.Components[i].Name < info.Components[j].Name
+	})
+	return info, nil
+}
+
+// Write writes info to w in the provided format: "text", "json", or "dot".
+func (info *Info) Write(w io.Writer, format string) error {
+	switch format {
+	case "", "text":
+		return info.writeText(w)
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(info)
+	case "dot":
+		return info.writeDOT(w)
+	default:
+		return fmt.Errorf("unknown format %q; want text, json, or dot", format)
+	}
+}
+
+// writeText writes a human readable description of info to w.
+func (info *Info) writeText(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Module version:   %s\n", info.ModuleVersion)
+	fmt.Fprintf(&b, "Deployer version: %s\n", info.DeployerVersion)
+	for _, c := range info.Components {
+		fmt.Fprintln(&b)
+		fmt.Fprintf(&b, "%s\n", c.Name)
+		if c.Routed {
+			fmt.Fprintln(&b, "  Routed")
+		}
+		if len(c.Methods) > 0 {
+			fmt.Fprintln(&b, "  Methods:")
+			for _, m := range c.Methods {
+				if m.NotRetriable {
+					fmt.Fprintf(&b, "    %s (not retriable)\n", m.Name)
+				} else {
+					fmt.Fprintf(&b, "    %s\n", m.Name)
+				}
+			}
+		}
+		if len(c.Listeners) > 0 {
+			fmt.Fprintln(&b, "  Listeners:")
+			for _, l := range c.Listeners {
+				fmt.Fprintf(&b, "    %s\n", l)
+			}
+		}
+		if len(c.Calls) > 0 {
+			fmt.Fprintln(&b, "  Calls:")
+			for _, callee := range c.Calls {
+				fmt.Fprintf(&b, "    %s\n", callee)
+			}
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeDOT writes the call graph in info to w in Graphviz DOT format. Every
+// node is labeled with the component's methods and listeners.
+func (info *Info) writeDOT(w io.Writer) error {
+	// See https://graphviz.org/doc/info/lang.html for details.
+	var b strings.Builder
+	fmt.Fprintln(&b, "digraph {")
+	fmt.Fprintln(&b, "    node [shape=box];")
+
+	// Nodes.
+	ids := map[string]int{}
+	for i, c := range info.Components {
+		ids[c.Name] = i
+		lines := []string{logging.ShortenComponent(c.Name)}
+		if c.Routed {
+			lines[0] += " (routed)"
+		}
+		for _, m := range c.Methods {
+			if m.NotRetriable {
+				lines = append(lines, m.Name+" (not retriable)")
+			} else {
+				lines = append(lines, m.Name)
+			}
+		}
+		for _, l := range c.Listeners {
+			lines = append(lines, "listener "+l)
+		}
+		// Component, method, and listener names are Go identifiers or
+		// paths, so they don't need to be escaped.
+		fmt.Fprintf(&b, "    %d [label=\"%s\"];\n", i, strings.Join(lines, `\n`))
+	}
+
+	// Edges.
+	for _, c := range info.Components {
+		for _, callee := range c.Calls {
+			fmt.Fprintf(&b, "    %d -> %d;\n", ids[c.Name], ids[callee])
+		}
+	}
+	fmt.Fprintln(&b, "}")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,102 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inspect
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var info = &Info{
+	ModuleVersion:   "v0.22.0",
+	DeployerVersion: "v0.22.0",
+	Components: []Component{
+		{
+			Name:      "example.com/app/Foo",
+			Routed:    true,
+			Methods:   []Method{{Name: "Get"}, {Name: "Put", NotRetriable: true}},
+			Listeners: []string{"lis"},
+		},
+		{
+			Name:  "github.com/ServiceWeaver/weaver/Main",
+			Calls: []string{"example.com/app/Foo"},
+		},
+	},
+}
+
+func TestWriteText(t *testing.T) {
+	var b strings.Builder
+	if err := info.Write(&b, "text"); err != nil {
+		t.Fatal(err)
+	}
+	const want = `Module version:   v0.22.0
+Deployer version: v0.22.0
+
+example.com/app/Foo
+  Routed
+  Methods:
+    Get
+    Put (not retriable)
+  Listeners:
+    lis
+
+github.com/ServiceWeaver/weaver/Main
+  Calls:
+    example.com/app/Foo
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Fatalf("(-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var b strings.Builder
+	if err := info.Write(&b, "json"); err != nil {
+		t.Fatal(err)
+	}
+	var got Info
+	if err := json.Unmarshal([]byte(b.String()), &got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(info, &got); diff != "" {
+		t.Fatalf("(-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	var b strings.Builder
+	if err := info.Write(&b, "dot"); err != nil {
+		t.Fatal(err)
+	}
+	const want = `digraph {
+    node [shape=box];
+    0 [label="app.Foo (routed)\nGet\nPut (not retriable)\nlistener lis"];
+    1 [label="weaver.Main"];
+    1 -> 0;
+}
+`
+	if diff := cmp.Diff(want, b.String()); diff != "" {
+		t.Fatalf("(-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	if err := info.Write(&strings.Builder{}, "yaml"); err == nil {
+		t.Fatal("unexpected success")
+	}
+}
@@ -133,13 +133,16 @@ persists, please file an issue at https://github.com/ServiceWeaver/weaver/issues
 		return fmt.Errorf("create deployer: %w", err)
 	}
 
-	// Run a status server.
+	// Run a status server. The token authorizes "weaver multi call" and
+	// "weaver multi loglevel" requests. It is only stored in the registry,
+	// which only the current user can read.
 	lis, err := net.Listen("tcp", "localhost:0")
 	if err != nil {
 		return fmt.Errorf("listen: %w", err)
 	}
+	token := uuid.New().String()
 	mux := http.NewServeMux()
-	status.RegisterServer(mux, d, d.logger)
+	status.RegisterServer(mux, d, token, d.logger)
 	go func() {
 		if err := serveHTTP(ctx, lis, mux); err != nil {
 			fmt.Fprintf(os.Stderr, "status server: %v\n", err)
@@ -170,6 +173,7 @@ persists, please file an issue at https://github.com/ServiceWeaver/weaver/issues
 		DeploymentId: deploymentId,
 		App:          appConfig.Name,
 		Addr:         lis.Addr().String(),
+		Token:        token,
 	}
 	fmt.Fprint(os.Stderr, reg.Rolodex())
 	if err := registry.Register(ctx, reg); err != nil {
@@ -69,15 +69,23 @@ type deployer struct {
 	running         errgroup.Group
 	loggerComponent *logger
 	traceDB         *traces.DB
+	traceSampler    *traces.TailSampler // nil if tail sampling is disabled
 
 	// statsProcessor tracks and computes stats to be rendered on the /statusz page.
 	statsProcessor *imetrics.StatsProcessor
 
-	mu      sync.Mutex            // guards the following
-	err     error                 // error that stopped the babysitter
-	groups  map[string]*group     // groups, by component name
-	proxies map[string]*proxyInfo // proxies, by listener name
+	mu        sync.Mutex                // guards the following
+	err       error                     // error that stopped the babysitter
+	groups    map[string]*group         // groups, by component name
+	proxies   map[string]*proxyInfo     // proxies, by listener name
+	logLevels map[string]storedLogLevel // log levels set by SetLogLevel, by component name
+}
 
+// A storedLogLevel is a log level set by SetLogLevel. It is sent to the
+// weavelets that host the component when they start.
+type storedLogLevel struct {
+	level   string    // minimum level, e.g., "debug"
+	expires time.Time // when the base level is restored, or zero for never
 }
 
 // A group contains information about a co-location group.
@@ -92,6 +100,12 @@ type group struct {
 	callable    []string                        // callable components for group
 	certPEM     []byte                          // group certificate
 	keyPEM      []byte                          // group private key
+
+	// All of the singleton components in a group share the same leader: one
+	// of the group's weavelets. See weaver.Singleton.
+	singletons map[string]bool    // started singleton components
+	leader     *envelope.Envelope // leader, or nil if there isn't one yet
+	led        map[string]bool    // singleton components the leader knows it leads
 }
 
 // A proxyInfo contains information about a proxy.
@@ -115,7 +129,11 @@ var _ envelope.EnvelopeHandler = &handler{}
 // time by canceling the passed-in context.
 func newDeployer(ctx context.Context, deploymentId string, config *MultiConfig, tmpDir string) (*deployer, error) {
 	// Create the log saver.
-	logsDB, err := logging.NewFileStore(logDir)
+	logOpts, err := fileStoreOptions(config.Logs)
+	if err != nil {
+		return nil, err
+	}
+	logsDB, err := logging.NewFileStoreWithOptions(logDir, logOpts)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create log storage: %w", err)
 	}
@@ -168,6 +186,7 @@ func newDeployer(ctx context.Context, deploymentId string, config *MultiConfig,
 		config:          config,
 		started:         time.Now(),
 		proxies:         map[string]*proxyInfo{},
+		logLevels:       map[string]storedLogLevel{},
 	}
 
 	// Form co-location groups.
@@ -175,6 +194,18 @@ func newDeployer(ctx context.Context, deploymentId string, config *MultiConfig,
 		return nil, err
 	}
 
+	// Start a goroutine that makes tail sampling decisions, if enabled.
+	if policy := config.App.TailSampling; policy != nil {
+		store := func(ctx context.Context, spans *protos.TraceSpans) error {
+			return traceDB.Store(ctx, config.App.Name, deploymentId, spans)
+		}
+		d.traceSampler = traces.NewTailSampler(policy, store, logger)
+		d.running.Go(func() error {
+			d.traceSampler.Run(d.ctx)
+			return nil
+		})
+	}
+
 	// Start a goroutine that collects metrics.
 	d.running.Go(func() error {
 		err := d.statsProcessor.CollectMetrics(d.ctx, d.readMetrics)
@@ -202,6 +233,39 @@ func newDeployer(ctx context.Context, deploymentId string, config *MultiConfig,
 	return d, nil
 }
 
+// fileStoreOptions returns the log file store options specified by the
+// provided "[multi.logs]" config section.
+func fileStoreOptions(logs *MultiConfig_LogOptions) (logging.FileStoreOptions, error) {
+	if logs == nil {
+		return logging.FileStoreOptions{}, nil
+	}
+	duration := func(name, value string) (time.Duration, error) {
+		if value == "" {
+			return 0, nil
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid logs.%s %q: %w", name, value, err)
+		}
+		return d, nil
+	}
+	age, err := duration("age", logs.Age)
+	if err != nil {
+		return logging.FileStoreOptions{}, err
+	}
+	retention, err := duration("retention", logs.Retention)
+	if err != nil {
+		return logging.FileStoreOptions{}, err
+	}
+	return logging.FileStoreOptions{
+		MaxFileSize:  logs.Size,
+		MaxFileAge:   age,
+		Compress:     logs.Compress,
+		MaxAge:       retention,
+		MaxTotalSize: logs.Budget,
+	}, nil
+}
+
 // computeGroups computes the colocation group information for the deployer.
 //
 // computeGroups places components into co-location groups based on the
@@ -243,6 +307,8 @@ func (d *deployer) computeGroups() error {
 			subscribers: map[string][]*envelope.Envelope{},
 			certPEM:     certPEM,
 			keyPEM:      keyPEM,
+			singletons:  map[string]bool{},
+			led:         map[string]bool{},
 		}
 		groups[component] = g
 		return g, nil
@@ -314,6 +380,14 @@ func (d *deployer) stop(err error) {
 //
 // REQUIRES: d.mu is held.
 func (g *group) routing(component string) *protos.RoutingInfo {
+	if g.singletons[component] {
+		// Calls to a singleton component are sent to its leader only.
+		var replicas []string
+		if g.leader != nil {
+			replicas = []string{g.leader.WeaveletInfo().DialAddr}
+		}
+		return &protos.RoutingInfo{Component: component, Replicas: replicas}
+	}
 	return &protos.RoutingInfo{
 		Component:  component,
 		Replicas:   maps.Keys(g.addresses),
@@ -332,8 +406,9 @@ func (d *deployer) startColocationGroup(g *group) error {
 	if d.err != nil {
 		return d.err
 	}
-	if len(g.envelopes) == defaultReplication {
-		// Already started.
+	if len(g.envelopes) > 0 {
+		// Already started. Note that a group may have fewer than
+		// defaultReplication envelopes if some of its weavelets failed over.
 		return nil
 	}
 
@@ -374,6 +449,9 @@ func (d *deployer) startColocationGroup(g *group) error {
 				envelope:   e,
 			}
 			err := e.Serve(h)
+			if d.failover(g, e, err) {
+				return nil
+			}
 			d.stop(err)
 			return err
 		})
@@ -383,11 +461,40 @@ func (d *deployer) startColocationGroup(g *group) error {
 		if err := e.UpdateComponents(components); err != nil {
 			return err
 		}
+		if err := d.sendLogLevels(g, e); err != nil {
+			return err
+		}
 		g.envelopes = append(g.envelopes, e)
 	}
 	return nil
 }
 
+// sendLogLevels sends the log levels set by SetLogLevel for the components in
+// the provided group to the provided weavelet.
+//
+// REQUIRES: d.mu is held.
+func (d *deployer) sendLogLevels(g *group, e *envelope.Envelope) error {
+	for component, level := range d.logLevels {
+		if d.groups[component] != g {
+			continue
+		}
+		req := &protos.SetLogLevelRequest{Component: component, Level: level.level}
+		if !level.expires.IsZero() {
+			ttl := time.Until(level.expires)
+			if ttl <= 0 {
+				// The level has expired, so the base level applies.
+				delete(d.logLevels, component)
+				continue
+			}
+			req.TtlNs = int64(ttl)
+		}
+		if err := e.SetLogLevel(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d *deployer) startMain() error {
 	return d.activateComponent(&protos.ActivateComponentRequest{
 		Component: runtime.Main,
@@ -416,8 +523,10 @@ func (h *handler) subscribeTo(req *protos.ActivateComponentRequest) error {
 		return fmt.Errorf("unknown component %q", req.Component)
 	}
 
-	if !req.Routed && h.g.name == target.name {
-		// Route locally.
+	if !req.Routed && !req.Singleton && h.g.name == target.name {
+		// Route locally. Calls to a singleton component are never routed
+		// locally because they have to be sent to the leader, which may be
+		// another weavelet in the group.
 		routing := &protos.RoutingInfo{Component: req.Component, Local: true}
 		return h.envelope.UpdateRoutingInfo(routing)
 	}
@@ -517,6 +626,11 @@ func (d *deployer) activateComponent(req *protos.ActivateComponentRequest) error
 			d.logger.Debug(fmt.Sprintf("Initial assignment for component %s:\n%s", req.Component, routing.FormatAssignment(assignment)))
 		}
 
+		// Record the singleton.
+		if req.Singleton {
+			target.singletons[req.Component] = true
+		}
+
 		// Notify the subscribers.
 		routing := target.routing(req.Component)
 		for _, sub := range target.subscribers[req.Component] {
@@ -527,7 +641,123 @@ func (d *deployer) activateComponent(req *protos.ActivateComponentRequest) error
 	}
 
 	// Start the co-location group, if it hasn't started already.
-	return d.startColocationGroup(target)
+	if err := d.startColocationGroup(target); err != nil {
+		return err
+	}
+
+	// Elect a leader for the group's singleton components, if needed.
+	return d.elect(target)
+}
+
+// elect elects a leader for the singleton components in the provided group,
+// if the group doesn't have a leader yet, and informs the leader of every
+// singleton component it leads.
+//
+// REQUIRES: d.mu is held.
+func (d *deployer) elect(g *group) error {
+	if len(g.singletons) == 0 || len(g.envelopes) == 0 {
+		return nil
+	}
+
+	elected := g.leader == nil
+	if elected {
+		g.leader = g.envelopes[0]
+		g.led = map[string]bool{}
+		d.logger.Info("Elected leader", "group", logging.ShortenComponent(g.name), "pid", g.leader.Pid())
+	}
+
+	// Inform the leader.
+	for component := range g.singletons {
+		if g.led[component] {
+			continue
+		}
+		if err := g.leader.UpdateLeadership(component, true); err != nil {
+			return err
+		}
+		g.led[component] = true
+	}
+	if !elected {
+		return nil
+	}
+
+	// Route calls to the new leader.
+	for component := range g.singletons {
+		routing := g.routing(component)
+		for _, sub := range g.subscribers[component] {
+			if err := sub.UpdateRoutingInfo(routing); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// failover handles the failure, with the provided error, of the weavelet
+// managed by the provided envelope in the provided group. If the group hosts
+// singleton components and has other replicas, the failed replica is removed
+// from the group, a new leader is elected if the failed replica was the
+// leader, and failover returns true. Otherwise, failover returns false, and
+// the deployment should be stopped.
+//
+// REQUIRES: d.mu is NOT held.
+func (d *deployer) failover(g *group, e *envelope.Envelope, err error) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.err != nil || d.ctx.Err() != nil {
+		// The deployment is being stopped.
+		return false
+	}
+	if err == nil {
+		// The weavelet exited cleanly (e.g., the main function returned).
+		return false
+	}
+	if len(g.singletons) == 0 || len(g.envelopes) <= 1 {
+		return false
+	}
+	i := slices.Index(g.envelopes, e)
+	if i < 0 {
+		return false
+	}
+	d.logger.Error("Weavelet failed; removing it from its group", "group", logging.ShortenComponent(g.name), "pid", e.Pid(), "err", err)
+
+	// Remove the replica. Note that the replica's listeners remain backends
+	// of their proxies, as proxy backends cannot be removed.
+	g.envelopes = slices.Delete(g.envelopes, i, i+1)
+	g.pids = slices.DeleteFunc(g.pids, func(pid int64) bool { return pid == int64(e.Pid()) })
+	delete(g.addresses, e.WeaveletInfo().DialAddr)
+	for _, other := range d.groups {
+		for component, subs := range other.subscribers {
+			other.subscribers[component] = slices.DeleteFunc(subs, func(sub *envelope.Envelope) bool { return sub == e })
+		}
+	}
+
+	// Update all assignments.
+	replicas := maps.Keys(g.addresses)
+	for component, assignment := range g.assignments {
+		g.assignments[component] = routingAlgo(assignment, replicas)
+	}
+
+	// Elect a new leader, if needed.
+	if g.leader == e {
+		g.leader = nil
+	}
+	if err := d.elect(g); err != nil {
+		d.logger.Error("Failed to elect leader", "group", logging.ShortenComponent(g.name), "err", err)
+		return false
+	}
+
+	// Notify subscribers.
+	for component := range g.started {
+		routing := g.routing(component)
+		for _, sub := range g.subscribers[component] {
+			if err := sub.UpdateRoutingInfo(routing); err != nil {
+				d.logger.Error("Failed to update routing info", "component", logging.ShortenComponent(component), "err", err)
+				return false
+			}
+		}
+	}
+	return true
 }
 
 // registerReplica registers the information about a colocation group replica
@@ -570,6 +800,9 @@ func (d *deployer) HandleLogEntry(_ context.Context, entry *protos.LogEntry) err
 
 // HandleTraceSpans implements the envelope.EnvelopeHandler interface.
 func (d *deployer) HandleTraceSpans(ctx context.Context, spans *protos.TraceSpans) error {
+	if d.traceSampler != nil {
+		return d.traceSampler.Add(ctx, spans)
+	}
 	return d.traceDB.Store(ctx, d.config.App.Name, d.deploymentId, spans)
 }
 
@@ -656,6 +889,65 @@ func (d *deployer) Profile(_ context.Context, req *protos.GetProfileRequest) (*p
 	return profile, nil
 }
 
+// CallComponent implements the status.Server interface. The call is made by
+// a weavelet in the main co-location group, as if the application made it.
+func (d *deployer) CallComponent(_ context.Context, req *protos.CallComponentRequest) (*protos.CallComponentReply, error) {
+	d.mu.Lock()
+	var e *envelope.Envelope
+	if g, ok := d.groups[runtime.Main]; ok && len(g.envelopes) > 0 {
+		e = g.envelopes[0]
+	}
+	d.mu.Unlock()
+
+	if e == nil {
+		return nil, fmt.Errorf("main component not running")
+	}
+	return e.CallComponent(req)
+}
+
+// SetLogLevel implements the status.Server interface. The log level is
+// changed on every weavelet that hosts the component. The level is also
+// recorded, and sent to the weavelets that host the component when they
+// start, until it expires.
+func (d *deployer) SetLogLevel(_ context.Context, req *protos.SetLogLevelRequest) (*protos.SetLogLevelReply, error) {
+	if req.Level != "" {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(req.Level)); err != nil {
+			return nil, fmt.Errorf("invalid log level %q: %w", req.Level, err)
+		}
+	}
+
+	d.mu.Lock()
+	g, ok := d.groups[req.Component]
+	if !ok {
+		d.mu.Unlock()
+		return nil, fmt.Errorf("unknown component %q", req.Component)
+	}
+	if req.Level == "" {
+		// The base level is restored.
+		delete(d.logLevels, req.Component)
+	} else {
+		level := storedLogLevel{level: req.Level}
+		if req.TtlNs > 0 {
+			level.expires = time.Now().Add(time.Duration(req.TtlNs))
+		}
+		d.logLevels[req.Component] = level
+	}
+	envelopes := slices.Clone(g.envelopes)
+	d.mu.Unlock()
+
+	var errs []error
+	for _, e := range envelopes {
+		if err := e.SetLogLevel(req); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return &protos.SetLogLevelReply{}, nil
+}
+
 // Status implements the status.Server interface.
 func (d *deployer) Status(context.Context) (*status.Status, error) {
 	d.mu.Lock()
@@ -46,6 +46,9 @@ var (
 				{Label: "profile", Command: fmt.Sprintf("weaver multi profile --duration=30s %s", deploymentId)},
 			}
 		},
+		LogSource: func(context.Context) (logging.Source, error) {
+			return logging.FileSource(logDir), nil
+		},
 	}
 
 	purgeSpec = &tool.PurgeSpec{
@@ -66,6 +69,9 @@ var (
 		"status":    status.StatusCommand("weaver multi", defaultRegistry),
 		"metrics":   status.MetricsCommand("weaver multi", defaultRegistry),
 		"profile":   status.ProfileCommand("weaver multi", defaultRegistry),
+		"call":      status.CallCommand("weaver multi", defaultRegistry),
+		"loglevel":  status.LogLevelCommand("weaver multi", defaultRegistry),
+		"traces":    status.TracesCommand("weaver multi", perfettoFile),
 		"purge":     tool.PurgeCmd(purgeSpec),
 		"version":   itool.VersionCmd("weaver multi"),
 	}
@@ -31,7 +31,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return multiLogger_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦24b3feb9:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/internal/tool/multi/multiLogger→;LogBatch=4d7d747c⟧\n",
 	})
 }
 
@@ -59,7 +59,7 @@ func (s multiLogger_local_stub) LogBatch(ctx context.Context, a0 *protos.LogEntr
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "multi.multiLogger.LogBatch", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "multi.multiLogger.LogBatch", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/tool/multi/multiLogger")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -91,7 +91,7 @@ func (s multiLogger_client_stub) LogBatch(ctx context.Context, a0 *protos.LogEnt
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "multi.multiLogger.LogBatch", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "multi.multiLogger.LogBatch", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/internal/tool/multi/multiLogger")))
 	}
 
 	defer func() {
@@ -135,10 +135,10 @@ func (s multiLogger_client_stub) LogBatch(ctx context.Context, a0 *protos.LogEnt
 // Note that "weaver generate" will always generate the error message below.
 // Everything is okay. The error message is only relevant if you see it when
 // you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][20]struct{}](`
+var _ codegen.LatestVersion = codegen.Version[[0][23]struct{}](`
 
 ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.20.0). The generated code is incompatible with the version of the
+version v0.23.0). The generated code is incompatible with the version of the
 github.com/ServiceWeaver/weaver module that you're using. The weaver module
 version can be found in your go.mod file or by running the following command.
 
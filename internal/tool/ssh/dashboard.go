@@ -15,6 +15,7 @@
 package ssh
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ServiceWeaver/weaver/internal/status"
@@ -32,4 +33,7 @@ var dashboardSpec = &status.DashboardSpec{
 			{Label: "follow logs", Command: fmt.Sprintf("weaver ssh logs --follow 'version==%q'", logging.Shorten(deploymentId))},
 		}
 	},
+	LogSource: func(context.Context) (logging.Source, error) {
+		return logging.FileSource(impl.LogDir), nil
+	},
 }
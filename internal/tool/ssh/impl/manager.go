@@ -175,6 +175,16 @@ func RunManager(ctx context.Context, config *SshConfig, locations map[string]str
 	traceSaver := func(spans *protos.TraceSpans) error {
 		return traceDB.Store(ctx, app.Name, config.DepId, spans)
 	}
+	if policy := app.TailSampling; policy != nil {
+		store := func(ctx context.Context, spans *protos.TraceSpans) error {
+			return traceDB.Store(ctx, app.Name, config.DepId, spans)
+		}
+		sampler := traces.NewTailSampler(policy, store, logger)
+		go sampler.Run(ctx)
+		traceSaver = func(spans *protos.TraceSpans) error {
+			return sampler.Add(ctx, spans)
+		}
+	}
 
 	// Form co-location.
 	colocation := map[string]string{}
@@ -301,7 +311,7 @@ func (m *manager) addHTTPHandlers(mux *http.ServeMux) {
 
 // registerStatusPages registers the status pages with the provided mux.
 func (m *manager) registerStatusPages(mux *http.ServeMux) {
-	status.RegisterServer(mux, m, m.logger)
+	status.RegisterServer(mux, m, "", m.logger)
 }
 
 // Status implements the status.Server interface.
@@ -392,6 +402,16 @@ func (m *manager) Profile(context.Context, *protos.GetProfileRequest) (*protos.G
 	return nil, nil
 }
 
+// CallComponent implements the status.Server interface.
+func (m *manager) CallComponent(context.Context, *protos.CallComponentRequest) (*protos.CallComponentReply, error) {
+	return nil, fmt.Errorf("calling components is not supported by the ssh deployer")
+}
+
+// SetLogLevel implements the status.Server interface.
+func (m *manager) SetLogLevel(context.Context, *protos.SetLogLevelRequest) (*protos.SetLogLevelReply, error) {
+	return nil, fmt.Errorf("changing log levels is not supported by the ssh deployer")
+}
+
 // group returns the named co-location group.
 //
 // REQUIRES: m.mu is not held.
@@ -534,6 +554,11 @@ func (m *manager) exportListener(_ context.Context, req *protos.ExportListenerRe
 }
 
 func (m *manager) startComponent(ctx context.Context, req *protos.ActivateComponentRequest) error {
+	if req.Singleton {
+		// Every location runs its own replica of a group, and the manager
+		// doesn't health check them, so it can't elect a leader reliably.
+		return fmt.Errorf("component %q is a singleton; singleton components are not supported by the ssh deployer", req.Component)
+	}
 	g := m.group(req.Component)
 
 	// Record the component.
@@ -0,0 +1,113 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+)
+
+// errorType is the reflect.Type of the error interface.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// defaultCallTimeout is the timeout of a call made on behalf of a
+// CallComponentRequest that doesn't specify one.
+const defaultCallTimeout = time.Minute
+
+// invokeJSON calls the provided method on component, an instance of a
+// component interface, with JSON encoded arguments. args is a JSON array of
+// the method's arguments, excluding the context. invokeJSON returns the
+// method's results, excluding the error, as a JSON array.
+func invokeJSON(ctx context.Context, component any, method string, args string) (string, error) {
+	m := reflect.ValueOf(component).MethodByName(method)
+	if !m.IsValid() {
+		return "", fmt.Errorf("method %q not found", method)
+	}
+	t := m.Type()
+	if t.NumIn() == 0 || t.NumOut() == 0 || t.Out(t.NumOut()-1) != errorType {
+		return "", fmt.Errorf("method %q is not a component method", method)
+	}
+
+	// Decode the arguments.
+	var raw []json.RawMessage
+	if args != "" {
+		if err := json.Unmarshal([]byte(args), &raw); err != nil {
+			return "", fmt.Errorf("decode arguments: %w", err)
+		}
+	}
+	if got, want := len(raw), t.NumIn()-1; got != want {
+		return "", fmt.Errorf("got %d arguments, want %d", got, want)
+	}
+	in := []reflect.Value{reflect.ValueOf(ctx)}
+	for i, arg := range raw {
+		v := reflect.New(t.In(i + 1))
+		if err := json.Unmarshal(arg, v.Interface()); err != nil {
+			return "", fmt.Errorf("decode argument %d: %w", i, err)
+		}
+		in = append(in, v.Elem())
+	}
+
+	// Call the method.
+	var out []reflect.Value
+	if t.IsVariadic() {
+		out = m.CallSlice(in)
+	} else {
+		out = m.Call(in)
+	}
+	if err := out[len(out)-1]; !err.IsNil() {
+		return "", err.Interface().(error)
+	}
+
+	// Encode the results.
+	results := []any{}
+	for _, v := range out[:len(out)-1] {
+		results = append(results, v.Interface())
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		return "", fmt.Errorf("encode results: %w", err)
+	}
+	return string(data), nil
+}
+
+// shardKey returns the shard key of the provided JSON encoded routing key of
+// a routed component.
+func shardKey(reg *codegen.Registration, routingKey string) (uint64, error) {
+	if !reg.Routed || reg.RoutingKey == nil || reg.ShardKeyFn == nil {
+		return 0, fmt.Errorf("component %q is not routed", reg.Name)
+	}
+	key := reflect.New(reg.RoutingKey)
+	if err := json.Unmarshal([]byte(routingKey), key.Interface()); err != nil {
+		return 0, fmt.Errorf("decode routing key: %w", err)
+	}
+	return reg.ShardKeyFn(key.Elem().Interface()), nil
+}
+
+// shardStub is a codegen.Stub that sends every call to the replica that owns
+// a fixed shard key, regardless of the shard key computed by the client stub.
+type shardStub struct {
+	codegen.Stub
+	key uint64
+}
+
+// Run implements the codegen.Stub interface.
+func (s shardStub) Run(ctx context.Context, method int, args []byte, _ uint64) ([]byte, error) {
+	return s.Stub.Run(ctx, method, args, s.key)
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+)
+
+type invokeTarget struct{}
+
+func (invokeTarget) Reverse(_ context.Context, s string) (string, error) {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes), nil
+}
+
+func (invokeTarget) Sum(_ context.Context, xs ...int) (int, int, error) {
+	sum := 0
+	for _, x := range xs {
+		sum += x
+	}
+	return sum, len(xs), nil
+}
+
+func (invokeTarget) Fail(context.Context) error {
+	return fmt.Errorf("failed")
+}
+
+func TestInvokeJSON(t *testing.T) {
+	ctx := context.Background()
+	for _, test := range []struct {
+		method, args, want string
+	}{
+		{"Reverse", `["hello"]`, `["olleh"]`},
+		{"Sum", `[[1, 2, 3]]`, `[6,3]`},
+		{"Sum", `[null]`, `[0,0]`},
+	} {
+		t.Run(test.method+test.args, func(t *testing.T) {
+			got, err := invokeJSON(ctx, invokeTarget{}, test.method, test.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Fatalf("invokeJSON(%q, %q): got %s, want %s", test.method, test.args, got, test.want)
+			}
+		})
+	}
+}
+
+func TestInvokeJSONErrors(t *testing.T) {
+	ctx := context.Background()
+	for _, test := range []struct {
+		method, args, want string
+	}{
+		{"Missing", `[]`, "not found"},
+		{"Reverse", `[]`, "got 0 arguments, want 1"},
+		{"Reverse", `[1]`, "decode argument 0"},
+		{"Reverse", `"hello"`, "decode arguments"},
+		{"Fail", `[]`, "failed"},
+	} {
+		t.Run(test.method+test.args, func(t *testing.T) {
+			_, err := invokeJSON(ctx, invokeTarget{}, test.method, test.args)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("invokeJSON(%q, %q): got %v, want error containing %q", test.method, test.args, err, test.want)
+			}
+		})
+	}
+}
+
+func TestShardKey(t *testing.T) {
+	reg := &codegen.Registration{
+		Name:       "Routed",
+		Routed:     true,
+		RoutingKey: reflect.TypeOf(""),
+		ShardKeyFn: func(key any) uint64 { return uint64(len(key.(string))) },
+	}
+	got, err := shardKey(reg, `"hello"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := uint64(5); got != want {
+		t.Fatalf("shardKey: got %d, want %d", got, want)
+	}
+
+	if _, err := shardKey(reg, `5`); err == nil {
+		t.Fatal("shardKey: unexpected success for mistyped routing key")
+	}
+	if _, err := shardKey(&codegen.Registration{Name: "Unrouted"}, `"hello"`); err == nil {
+		t.Fatal("shardKey: unexpected success for unrouted component")
+	}
+}
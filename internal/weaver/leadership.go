@@ -0,0 +1,84 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"sync"
+)
+
+// Leadership records whether a replica of a singleton component is the
+// component's leader. The leadership of a replica is updated by the deployer.
+// See weaver.Singleton for details.
+type Leadership struct {
+	mu      sync.Mutex
+	leader  bool          // is the replica the leader?
+	changed chan struct{} // closed and replaced whenever leader changes
+}
+
+// NewLeadership returns a new Leadership with the provided initial state.
+func NewLeadership(leader bool) *Leadership {
+	return &Leadership{leader: leader, changed: make(chan struct{})}
+}
+
+// IsLeader returns whether the replica is currently the leader.
+func (l *Leadership) IsLeader() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.leader
+}
+
+// Lead blocks until the replica is the leader and returns a context that is
+// cancelled when the replica stops being the leader or when ctx is cancelled.
+// Like context.WithCancel, Lead also returns a cancel function that the caller
+// must call once it's done with the returned context. Lead returns an error if
+// ctx is cancelled before the replica becomes the leader.
+func (l *Leadership) Lead(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	for {
+		l.mu.Lock()
+		leader, changed := l.leader, l.changed
+		l.mu.Unlock()
+
+		if leader {
+			lctx, cancel := context.WithCancel(ctx)
+			go func() {
+				select {
+				case <-changed:
+				case <-lctx.Done():
+				}
+				cancel()
+			}()
+			return lctx, cancel, nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+	}
+}
+
+// Update updates whether the replica is the leader.
+func (l *Leadership) Update(leader bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.leader == leader {
+		return
+	}
+	l.leader = leader
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLeadershipLead(t *testing.T) {
+	ctx := context.Background()
+	l := NewLeadership(false)
+	if l.IsLeader() {
+		t.Fatal("IsLeader: got true, want false")
+	}
+
+	// Lead blocks until the replica becomes the leader.
+	leading := make(chan context.Context)
+	go func() {
+		lctx, cancel, err := l.Lead(ctx)
+		if err != nil {
+			t.Error(err)
+		}
+		t.Cleanup(cancel)
+		leading <- lctx
+	}()
+	select {
+	case <-leading:
+		t.Fatal("Lead returned before the replica became the leader")
+	case <-time.After(10 * time.Millisecond):
+	}
+	l.Update(true)
+	lctx := <-leading
+	if !l.IsLeader() {
+		t.Fatal("IsLeader: got false, want true")
+	}
+	if err := lctx.Err(); err != nil {
+		t.Fatalf("leader context cancelled while leading: %v", err)
+	}
+
+	// The leader context is cancelled when the replica stops being the
+	// leader.
+	l.Update(false)
+	select {
+	case <-lctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("leader context not cancelled after losing leadership")
+	}
+}
+
+func TestLeadershipLeadCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := NewLeadership(false).Lead(ctx); err == nil {
+		t.Fatal("Lead: unexpected success")
+	}
+
+	// Cancelling the provided context cancels the leader context.
+	ctx, cancel = context.WithCancel(context.Background())
+	lctx, lcancel, err := NewLeadership(true).Lead(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lcancel()
+	cancel()
+	<-lctx.Done()
+}
+
+func TestLeadershipLeadCancelFunc(t *testing.T) {
+	// Calling the returned cancel function cancels the leader context, even
+	// if the replica is still the leader.
+	l := NewLeadership(true)
+	lctx, cancel, err := l.Lead(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	<-lctx.Done()
+	if !l.IsLeader() {
+		t.Fatal("IsLeader: got false, want true")
+	}
+}
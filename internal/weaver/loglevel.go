@@ -0,0 +1,124 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// defaultLogLevel is the base level of components that aren't assigned one in
+// the config (see protos.AppConfig.LogLevels).
+const defaultLogLevel = slog.LevelInfo
+
+// logLevel is the minimum level of a component's logger. The level starts at
+// the component's base level. It can be changed while the component is
+// running (see protos.SetLogLevelRequest), and the change is picked up by
+// every logger that uses the logLevel.
+type logLevel struct {
+	base  slog.Level // base level
+	level slog.LevelVar
+
+	mu    sync.Mutex
+	timer *time.Timer // restores the base level, or nil
+}
+
+var _ slog.Leveler = &logLevel{}
+
+// newLogLevel returns a new logLevel with the provided base level.
+func newLogLevel(base slog.Level) *logLevel {
+	l := &logLevel{base: base}
+	l.level.Set(base)
+	return l
+}
+
+// newLogLevels returns the log levels of the provided components, given the
+// base levels in the config, keyed by component name.
+func newLogLevels(regs []*codegen.Registration, levels map[string]string) (map[string]*logLevel, error) {
+	result := map[string]*logLevel{}
+	for _, reg := range regs {
+		base := defaultLogLevel
+		if s, ok := levels[reg.Name]; ok {
+			var err error
+			if base, err = parseLogLevel(s); err != nil {
+				return nil, err
+			}
+		}
+		result[reg.Name] = newLogLevel(base)
+	}
+	return result, nil
+}
+
+// Level implements the slog.Leveler interface.
+func (l *logLevel) Level() slog.Level {
+	return l.level.Level()
+}
+
+// set sets the minimum level. If ttl is positive, the base level is restored
+// after ttl, unless the level is set again in the meantime.
+func (l *logLevel) set(level slog.Level, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+	l.level.Set(level)
+	if ttl <= 0 {
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(ttl, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if l.timer != timer {
+			// The level was set again after this timer was created, but
+			// before the timer could be stopped.
+			return
+		}
+		l.level.Set(l.base)
+		l.timer = nil
+	})
+	l.timer = timer
+}
+
+// update updates the minimum level as specified by the provided request.
+func (l *logLevel) update(req *protos.SetLogLevelRequest) error {
+	if req.Level == "" {
+		l.set(l.base, 0)
+		return nil
+	}
+	level, err := parseLogLevel(req.Level)
+	if err != nil {
+		return err
+	}
+	l.set(level, time.Duration(req.TtlNs))
+	return nil
+}
+
+// parseLogLevel parses a log level (e.g., "debug", "info", "warn", "error").
+func parseLogLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(s)); err != nil {
+		return 0, fmt.Errorf("invalid log level %q: %w", s, err)
+	}
+	return level, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/logging"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+func TestLogLevel(t *testing.T) {
+	var entries []*protos.LogEntry
+	level := newLogLevel(slog.LevelInfo)
+	logger := slog.New(&logging.LogHandler{
+		Write: func(e *protos.LogEntry) { entries = append(entries, e) },
+		Level: level,
+	}).With("foo", "bar")
+
+	logAll := func() []string {
+		entries = nil
+		logger.Debug("debug")
+		logger.Info("info")
+		logger.Warn("warn")
+		logger.Error("error")
+		var msgs []string
+		for _, e := range entries {
+			msgs = append(msgs, e.Msg)
+		}
+		return msgs
+	}
+
+	for _, test := range []struct {
+		level string
+		want  int // number of logged entries
+	}{
+		{"", 3},
+		{"warn", 2},
+		{"ERROR", 1},
+		{"info", 3},
+		{"debug", 4},
+		{"", 3},
+	} {
+		if err := level.update(&protos.SetLogLevelRequest{Level: test.level}); err != nil {
+			t.Fatal(err)
+		}
+		if got := logAll(); len(got) != test.want {
+			t.Errorf("level %q: got %v, want %d entries", test.level, got, test.want)
+		}
+	}
+
+	if err := level.update(&protos.SetLogLevelRequest{Level: "loud"}); err == nil {
+		t.Error("unexpected success setting an invalid level")
+	}
+}
+
+func TestLogLevelTTL(t *testing.T) {
+	ctx := context.Background()
+	level := newLogLevel(slog.LevelWarn)
+	const ttl = 10 * time.Millisecond
+
+	// The base level is restored after the ttl expires.
+	level.set(slog.LevelError, ttl)
+	if got, want := level.Level(), slog.LevelError; got != want {
+		t.Fatalf("Level: got %v, want %v", got, want)
+	}
+	waitForBase := func() {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for level.Level() != slog.LevelWarn {
+			if time.Now().After(deadline) {
+				t.Fatalf("level not restored after %v", ttl)
+			}
+			time.Sleep(ttl)
+		}
+	}
+	waitForBase()
+
+	// Setting the level again cancels a pending restore.
+	level.set(slog.LevelWarn, ttl)
+	level.set(slog.LevelInfo, 0)
+	time.Sleep(10 * ttl)
+	if got, want := level.Level(), slog.LevelInfo; got != want {
+		t.Fatalf("Level: got %v, want %v", got, want)
+	}
+
+	// A restore can be scheduled again.
+	level.set(slog.LevelDebug, ttl)
+	waitForBase()
+	if slog.New(&logging.LogHandler{Level: level}).Enabled(ctx, slog.LevelInfo) {
+		t.Fatal("base level should disable info")
+	}
+}
+
+func TestNewLogLevels(t *testing.T) {
+	regs := []*codegen.Registration{{Name: "a"}, {Name: "b"}}
+	levels, err := newLogLevels(regs, map[string]string{"a": "debug"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := levels["a"].Level(), slog.LevelDebug; got != want {
+		t.Errorf("a: got %v, want %v", got, want)
+	}
+	if got, want := levels["b"].Level(), defaultLogLevel; got != want {
+		t.Errorf("b: got %v, want %v", got, want)
+	}
+
+	// Restoring the base level of a restores debug.
+	if err := levels["a"].update(&protos.SetLogLevelRequest{Level: "error", TtlNs: int64(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := levels["a"].update(&protos.SetLogLevelRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := levels["a"].Level(), slog.LevelDebug; got != want {
+		t.Errorf("a: got %v, want %v", got, want)
+	}
+
+	if _, err := newLogLevels(regs, map[string]string{"a": "loud"}); err == nil {
+		t.Error("unexpected success with an invalid level")
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/logging"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// suppressedLogsInterval is the interval at which the number of log entries
+// suppressed by log limits is reported.
+const suppressedLogsInterval = 10 * time.Second
+
+// newLogLimiters returns the log limiters, by component name, of the provided
+// components. Components without log limits do not have a limiter.
+func newLogLimiters(regs []*codegen.Registration, limits []*protos.LogLimit) (map[string]*logging.Limiter, error) {
+	limiters := map[string]*logging.Limiter{}
+	for _, reg := range regs {
+		limiter, err := logging.NewLimiter(reg.Name, limits)
+		if err != nil {
+			return nil, err
+		}
+		if limiter != nil {
+			limiters[reg.Name] = limiter
+		}
+	}
+	return limiters, nil
+}
+
+// reportSuppressedLogs periodically logs the number of log entries suppressed
+// by the provided limiters, until ctx is cancelled. handler(component) returns
+// the handler used to log the report for the provided component. The handler
+// should not be subject to the component's log limits.
+func reportSuppressedLogs(ctx context.Context, limiters map[string]*logging.Limiter, handler func(component string) slog.Handler) {
+	if len(limiters) == 0 {
+		return
+	}
+	ticker := time.NewTicker(suppressedLogsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for component, limiter := range limiters {
+				logSuppressed(slog.New(handler(component)), limiter)
+			}
+		}
+	}
+}
+
+// logSuppressed logs the number of log entries, by level, suppressed by the
+// provided limiter since the last call.
+func logSuppressed(logger *slog.Logger, limiter *logging.Limiter) {
+	suppressed := limiter.Suppressed()
+	levels := make([]slog.Level, 0, len(suppressed))
+	for level := range suppressed {
+		levels = append(levels, level)
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i] < levels[j] })
+	for _, level := range levels {
+		n := suppressed[level]
+		logger.Warn(fmt.Sprintf("%d %s messages suppressed", n, level),
+			"level", level.String(),
+			"count", n,
+			"interval", suppressedLogsInterval)
+	}
+}
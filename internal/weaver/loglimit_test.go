@@ -0,0 +1,55 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/logging"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLogSuppressed(t *testing.T) {
+	limiter, err := logging.NewLimiter("a", []*protos.LogLimit{{Sample: 0.5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	for _, level := range []slog.Level{slog.LevelDebug, slog.LevelInfo} {
+		for i := 0; i < 10; i++ {
+			limiter.Allow(level, now)
+		}
+	}
+
+	var msgs []string
+	logger := slog.New(&logging.LogHandler{
+		Write: func(e *protos.LogEntry) { msgs = append(msgs, e.Msg) },
+	})
+	logSuppressed(logger, limiter)
+	want := []string{"5 DEBUG messages suppressed", "5 INFO messages suppressed"}
+	if diff := cmp.Diff(want, msgs); diff != "" {
+		t.Fatalf("logged messages (-want +got):\n%s", diff)
+	}
+
+	// Nothing is logged when no entries have been suppressed since.
+	msgs = nil
+	logSuppressed(logger, limiter)
+	if len(msgs) != 0 {
+		t.Fatalf("unexpected messages %v", msgs)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"math"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// KeyRange is the range [Start, End) of the shard key space of a routed
+// component. A key range that ends at math.MaxUint64 also contains
+// math.MaxUint64, as the last slice of an assignment covers every shard key
+// from its start up.
+type KeyRange struct {
+	Start uint64 // inclusive
+	End   uint64 // exclusive, unless math.MaxUint64
+}
+
+// OwnershipChange is a change in the key ranges of a routed component owned
+// by a weavelet. See weaver.OwnershipObserver for details.
+type OwnershipChange struct {
+	Version  uint64               // version of the assignment
+	Acquired []KeyRange           // newly owned key ranges
+	Released []KeyRange           // no longer owned key ranges
+	ShardKey func(key any) uint64 // maps routing keys to shard keys
+}
+
+// ownership tracks the key ranges of a routed component owned by a weavelet
+// and reports every change in ownership, in order, to a notify function.
+type ownership struct {
+	addr     string               // dialable address found in assignments
+	shardKey func(key any) uint64 // maps routing keys to shard keys
+	notify   func(OwnershipChange)
+
+	mu        sync.Mutex        // guards the following fields
+	version   uint64            // version of the latest assignment
+	owned     []KeyRange        // sorted, disjoint owned key ranges
+	pending   []OwnershipChange // changes not yet reported
+	notifying bool              // is a goroutine reporting pending changes?
+}
+
+// newOwnership returns a new ownership for the weavelet with the provided
+// address. notify is called, in a separate goroutine, for every change in
+// ownership. Calls to notify are never concurrent.
+func newOwnership(addr string, shardKey func(key any) uint64, notify func(OwnershipChange)) *ownership {
+	return &ownership{addr: addr, shardKey: shardKey, notify: notify}
+}
+
+// update updates the owned key ranges with the latest assignment. Stale
+// assignments are ignored.
+func (o *ownership) update(assignment *protos.Assignment) {
+	owned := ownedRanges(assignment, o.addr)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.version != 0 && assignment.Version <= o.version {
+		return
+	}
+	change := OwnershipChange{
+		Version:  assignment.Version,
+		Acquired: subtract(owned, o.owned),
+		Released: subtract(o.owned, owned),
+		ShardKey: o.shardKey,
+	}
+	o.version = assignment.Version
+	o.owned = owned
+	if len(change.Acquired) == 0 && len(change.Released) == 0 {
+		return
+	}
+
+	// Report the change. We don't call notify while holding the lock, and we
+	// don't call it in the caller's goroutine, as it may block.
+	o.pending = append(o.pending, change)
+	if o.notifying {
+		return
+	}
+	o.notifying = true
+	go func() {
+		for {
+			o.mu.Lock()
+			if len(o.pending) == 0 {
+				o.notifying = false
+				o.mu.Unlock()
+				return
+			}
+			change := o.pending[0]
+			o.pending = o.pending[1:]
+			o.mu.Unlock()
+			o.notify(change)
+		}
+	}()
+}
+
+// ownedRanges returns the sorted, disjoint key ranges assigned to the replica
+// with the provided address. Adjacent key ranges are merged.
+func ownedRanges(assignment *protos.Assignment, addr string) []KeyRange {
+	var owned []KeyRange
+	for i, slice := range assignment.Slices {
+		var end uint64 = math.MaxUint64
+		if i < len(assignment.Slices)-1 {
+			end = assignment.Slices[i+1].Start
+		}
+		found := false
+		for _, replica := range slice.Replicas {
+			if replica == addr {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		if n := len(owned); n > 0 && owned[n-1].End == slice.Start {
+			owned[n-1].End = end
+			continue
+		}
+		owned = append(owned, KeyRange{Start: slice.Start, End: end})
+	}
+	return owned
+}
+
+// subtract returns the key ranges in xs that are not in ys. Both xs and ys
+// must be sorted and disjoint.
+func subtract(xs, ys []KeyRange) []KeyRange {
+	var diff []KeyRange
+	j := 0
+	for _, x := range xs {
+		start := x.Start
+		for j < len(ys) && ys[j].End <= start {
+			j++
+		}
+		for k := j; k < len(ys) && ys[k].Start < x.End; k++ {
+			if ys[k].Start > start {
+				diff = append(diff, KeyRange{Start: start, End: ys[k].Start})
+			}
+			start = max(start, ys[k].End)
+		}
+		if start < x.End {
+			diff = append(diff, KeyRange{Start: start, End: x.End})
+		}
+	}
+	return diff
+}
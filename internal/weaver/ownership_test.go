@@ -0,0 +1,126 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestOwnedRanges(t *testing.T) {
+	assignment := &protos.Assignment{
+		Slices: []*protos.Assignment_Slice{
+			{Start: 0, Replicas: []string{"a"}},
+			{Start: 10, Replicas: []string{"a", "b"}},
+			{Start: 20, Replicas: []string{"b"}},
+			{Start: 30, Replicas: []string{"a"}},
+		},
+	}
+	for _, test := range []struct {
+		addr string
+		want []KeyRange
+	}{
+		{"a", []KeyRange{{0, 20}, {30, math.MaxUint64}}},
+		{"b", []KeyRange{{10, 30}}},
+		{"c", nil},
+	} {
+		t.Run(test.addr, func(t *testing.T) {
+			got := ownedRanges(assignment, test.addr)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("ownedRanges (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		xs, ys []KeyRange
+		want   []KeyRange
+	}{
+		{"Empty", nil, []KeyRange{{0, 10}}, nil},
+		{"Nothing", []KeyRange{{0, 10}}, nil, []KeyRange{{0, 10}}},
+		{"Disjoint", []KeyRange{{0, 10}}, []KeyRange{{10, 20}}, []KeyRange{{0, 10}}},
+		{"Equal", []KeyRange{{0, 10}}, []KeyRange{{0, 10}}, nil},
+		{"Prefix", []KeyRange{{0, 10}}, []KeyRange{{0, 5}}, []KeyRange{{5, 10}}},
+		{"Suffix", []KeyRange{{0, 10}}, []KeyRange{{5, 15}}, []KeyRange{{0, 5}}},
+		{"Middle", []KeyRange{{0, 10}}, []KeyRange{{3, 4}, {6, 7}}, []KeyRange{{0, 3}, {4, 6}, {7, 10}}},
+		{"Spanning", []KeyRange{{0, 10}, {20, 30}}, []KeyRange{{5, 25}}, []KeyRange{{0, 5}, {25, 30}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got := subtract(test.xs, test.ys)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("subtract (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipUpdate(t *testing.T) {
+	changes := make(chan OwnershipChange, 10)
+	o := newOwnership("a", nil, func(change OwnershipChange) { changes <- change })
+
+	for _, assignment := range []*protos.Assignment{
+		{
+			Version: 1,
+			Slices:  []*protos.Assignment_Slice{{Start: 0, Replicas: []string{"a"}}},
+		},
+		{
+			// Unchanged ownership.
+			Version: 2,
+			Slices:  []*protos.Assignment_Slice{{Start: 0, Replicas: []string{"a", "b"}}},
+		},
+		{
+			Version: 3,
+			Slices: []*protos.Assignment_Slice{
+				{Start: 0, Replicas: []string{"a"}},
+				{Start: 100, Replicas: []string{"b"}},
+			},
+		},
+		{
+			// Stale assignment.
+			Version: 2,
+			Slices:  []*protos.Assignment_Slice{{Start: 0, Replicas: []string{"b"}}},
+		},
+	} {
+		o.update(assignment)
+	}
+
+	want := []OwnershipChange{
+		{Version: 1, Acquired: []KeyRange{{0, math.MaxUint64}}},
+		{Version: 3, Released: []KeyRange{{100, math.MaxUint64}}},
+	}
+	for _, w := range want {
+		select {
+		case got := <-changes:
+			if diff := cmp.Diff(w, got, cmpopts.IgnoreFields(OwnershipChange{}, "ShardKey")); diff != "" {
+				t.Fatalf("change (-want +got):\n%s", diff)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("change %v not reported", w)
+		}
+	}
+	select {
+	case got := <-changes:
+		t.Fatalf("unexpected change %v", got)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+)
+
+// RecordCallsEnvKey is the environment variable that enables call recording
+// in deployed applications. If set, every weavelet records the remote
+// component method calls it makes to a new file in the named directory.
+const RecordCallsEnvKey = "SERVICEWEAVER_RECORD_CALLS"
+
+// A CallRecord is a recorded remote component method call.
+type CallRecord struct {
+	Component string // full component name, e.g., "github.com/foo/Bar"
+	Method    string // method name
+	Args      []byte // arguments, encoded by the generated client stub
+	Results   []byte // results, encoded by the generated server stub
+}
+
+// A CallRecorder writes CallRecords to an io.Writer. Records are framed by a
+// four byte length, followed by the record encoded with a codegen.Encoder. A
+// CallRecorder is safe for concurrent use by multiple goroutines.
+type CallRecorder struct {
+	mu  sync.Mutex
+	w   io.Writer
+	err error // the first write error, if any
+}
+
+// NewCallRecorder returns a CallRecorder that writes to w.
+func NewCallRecorder(w io.Writer) *CallRecorder {
+	return &CallRecorder{w: w}
+}
+
+// Record writes the provided record. After a write fails, all future records
+// are dropped, and the error is returned by Err.
+func (r *CallRecorder) Record(record CallRecord) {
+	enc := codegen.NewEncoder()
+	enc.Uint32(0) // placeholder for the length
+	enc.String(record.Component)
+	enc.String(record.Method)
+	enc.Bytes(record.Args)
+	enc.Bytes(record.Results)
+	data := enc.Data()
+	binary.LittleEndian.PutUint32(data, uint32(len(data)-4))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return
+	}
+	_, r.err = r.w.Write(data)
+}
+
+// Err returns the first error encountered while writing records, if any.
+func (r *CallRecorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+// ReadCallRecords reads the records written by a CallRecorder.
+func ReadCallRecords(r io.Reader) ([]CallRecord, error) {
+	br := bufio.NewReader(r)
+	var records []CallRecord
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(br, size[:]); errors.Is(err, io.EOF) {
+			return records, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("read call record: %w", err)
+		}
+		data := make([]byte, binary.LittleEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(br, data); err != nil {
+			return nil, fmt.Errorf("read call record: %w", err)
+		}
+		record, err := decodeCallRecord(data)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+}
+
+// decodeCallRecord decodes a record encoded by CallRecorder.Record.
+func decodeCallRecord(data []byte) (record CallRecord, err error) {
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+		if err != nil {
+			err = fmt.Errorf("decode call record: %w", err)
+		}
+	}()
+	dec := codegen.NewDecoder(data)
+	record.Component = dec.String()
+	record.Method = dec.String()
+	record.Args = dec.Bytes()
+	record.Results = dec.Bytes()
+	if !dec.Empty() {
+		return CallRecord{}, errors.New("trailing bytes")
+	}
+	return record, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCallRecords(t *testing.T) {
+	want := []CallRecord{
+		{Component: "a/A", Method: "Foo", Args: []byte{1, 2, 3}, Results: []byte{4}},
+		{Component: "b/B", Method: "Bar", Args: nil, Results: []byte{}},
+	}
+	var buf bytes.Buffer
+	recorder := NewCallRecorder(&buf)
+	for _, record := range want {
+		recorder.Record(record)
+	}
+	if err := recorder.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadCallRecords(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("ReadCallRecords (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadTruncatedCallRecords(t *testing.T) {
+	var buf bytes.Buffer
+	NewCallRecorder(&buf).Record(CallRecord{Component: "a/A", Method: "Foo"})
+	truncated := buf.Bytes()[:buf.Len()-1]
+	if _, err := ReadCallRecords(bytes.NewReader(truncated)); err == nil {
+		t.Fatal("ReadCallRecords: unexpected success")
+	}
+}
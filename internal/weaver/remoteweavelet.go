@@ -26,6 +26,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ServiceWeaver/weaver/internal/config"
 	"github.com/ServiceWeaver/weaver/internal/envelope/conn"
@@ -49,6 +50,9 @@ var readyMethodKey = call.MakeMethodKey("", "ready")
 type RemoteWeaveletOptions struct {
 	Fakes         map[reflect.Type]any // component fakes, by component interface type
 	InjectRetries int                  // Number of artificial retries to inject per retriable call
+	InjectFault   FaultInjector        // If not nil, injects faults into remote calls
+	SyncTraces    bool                 // If true, export spans as soon as they end
+	RecordCalls   *CallRecorder        // If not nil, records remote calls
 }
 
 // RemoteWeavelet is a weavelet that runs some components locally, but
@@ -63,6 +67,7 @@ type RemoteWeavelet struct {
 	logDst    *remoteLogger         // for writing log entries
 	syslogger *slog.Logger          // system logger
 	tracer    trace.Tracer          // tracer used by all components
+	sampler   *sampler              // samples traces
 
 	componentsByName map[string]*component       // component name -> component
 	componentsByIntf map[reflect.Type]*component // component interface type -> component
@@ -107,8 +112,12 @@ type component struct {
 	stubErr  error     // non-nil if stub creation fails
 	stub     *stub     // network stub to remote component
 
-	local register.WriteOnce[bool] // routed locally?
-	load  *loadCollector           // non-nil for routed components
+	local      register.WriteOnce[bool] // routed locally?
+	load       *loadCollector           // non-nil for routed components
+	ownership  *ownership               // non-nil for routed components
+	leadership *Leadership              // non-nil for singleton components
+	logLevel   *logLevel                // minimum log level
+	logLimiter *logging.Limiter         // log limits, or nil for no limits
 }
 
 // listener is a network listener and the proxy address that should be used to
@@ -151,12 +160,37 @@ func NewRemoteWeavelet(ctx context.Context, regs []*codegen.Registration, bootst
 	w.syslogger = w.logger("weavelet", "serviceweaver/system", "")
 
 	// Set up tracing.
+	sampling, err := runtime.ParseTraceSampling(info.Sections)
+	if err != nil {
+		return nil, fmt.Errorf("parse trace sampling: %w", err)
+	}
+	w.sampler = newSampler(sampling)
 	exporter := traceio.NewWriter(w.conn.SendTraceSpans)
-	w.tracer = tracer(exporter, info.App, info.DeploymentId, info.Id)
+	w.tracer = tracer(exporter, info.App, info.DeploymentId, info.Id, opts.SyncTraces, w.sampler)
+
+	// Set up log limits.
+	limits, err := runtime.ParseLogLimits(info.Sections)
+	if err != nil {
+		return nil, fmt.Errorf("parse log limits: %w", err)
+	}
+	limiters, err := newLogLimiters(regs, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set up log levels.
+	baseLevels, err := runtime.ParseLogLevels(info.Sections)
+	if err != nil {
+		return nil, fmt.Errorf("parse log levels: %w", err)
+	}
+	levels, err := newLogLevels(regs, baseLevels)
+	if err != nil {
+		return nil, err
+	}
 
 	// Initialize the component structs.
 	for _, reg := range regs {
-		c := &component{reg: reg}
+		c := &component{reg: reg, logLevel: levels[reg.Name], logLimiter: limiters[reg.Name]}
 		w.componentsByName[reg.Name] = c
 		w.componentsByIntf[reg.Iface] = c
 		w.componentsByImpl[reg.Impl] = c
@@ -166,6 +200,20 @@ func NewRemoteWeavelet(ctx context.Context, regs []*codegen.Registration, bootst
 			// TODO(rgrandl): In the future, we may want to collect load for
 			// all components.
 			c.load = newLoadCollector(reg.Name, w.conn.WeaveletInfo().DialAddr)
+			c.ownership = newOwnership(w.conn.WeaveletInfo().DialAddr, reg.ShardKeyFn, func(change OwnershipChange) {
+				impl, err := w.GetImpl(c.reg.Impl)
+				if err != nil {
+					// GetImpl logs the error.
+					return
+				}
+				NotifyOwnership(w.ctx, impl, change)
+			})
+		}
+
+		// Initialize the leadership. A replica isn't the leader until the
+		// envelope says so.
+		if reg.Singleton {
+			c.leadership = NewLeadership(false)
 		}
 
 		// Initialize the client side of the mTLS protocol.
@@ -204,6 +252,12 @@ func NewRemoteWeavelet(ctx context.Context, regs []*codegen.Registration, bootst
 		w.logDst.run(ctx, logFn)
 		return nil
 	})
+	servers.Go(func() error {
+		reportSuppressedLogs(ctx, limiters, func(component string) slog.Handler {
+			return w.logHandler(component)
+		})
+		return nil
+	})
 
 	// Serve deployer API requests on the weavelet conn.
 	servers.Go(func() error {
@@ -256,25 +310,8 @@ func (w *RemoteWeavelet) getIntf(t reflect.Type, requester string) (any, error)
 	}
 
 	// Activate the component.
-	c.activateInit.Do(func() {
-		name := logging.ShortenComponent(c.reg.Name)
-		w.syslogger.Debug("Activating", "component", name)
-		errMsg := fmt.Sprintf("cannot activate component %q", c.reg.Name)
-		c.activateErr = w.repeatedly(w.ctx, errMsg, func() error {
-			request := &protos.ActivateComponentRequest{
-				Component: c.reg.Name,
-				Routed:    c.reg.Routed,
-			}
-			return w.conn.ActivateComponentRPC(request)
-		})
-		if c.activateErr != nil {
-			w.syslogger.Error("Failed to activate", "component", name, "err", c.activateErr)
-		} else {
-			w.syslogger.Debug("Activated", "component", name)
-		}
-	})
-	if c.activateErr != nil {
-		return nil, c.activateErr
+	if err := w.activate(c); err != nil {
+		return nil, err
 	}
 
 	// Return a local stub.
@@ -294,6 +331,30 @@ func (w *RemoteWeavelet) getIntf(t reflect.Type, requester string) (any, error)
 	return c.reg.ClientStubFn(stub, requester), nil
 }
 
+// activate activates the provided component, if it hasn't already been
+// activated.
+func (w *RemoteWeavelet) activate(c *component) error {
+	c.activateInit.Do(func() {
+		name := logging.ShortenComponent(c.reg.Name)
+		w.syslogger.Debug("Activating", "component", name)
+		errMsg := fmt.Sprintf("cannot activate component %q", c.reg.Name)
+		c.activateErr = w.repeatedly(w.ctx, errMsg, func() error {
+			request := &protos.ActivateComponentRequest{
+				Component: c.reg.Name,
+				Routed:    c.reg.Routed,
+				Singleton: c.reg.Singleton,
+			}
+			return w.conn.ActivateComponentRPC(request)
+		})
+		if c.activateErr != nil {
+			w.syslogger.Error("Failed to activate", "component", name, "err", c.activateErr)
+		} else {
+			w.syslogger.Debug("Activated", "component", name)
+		}
+	})
+	return c.activateErr
+}
+
 // redirect creates a component interface for c that redirects calls to the
 // component named by target at address.
 func (w *RemoteWeavelet) redirect(requester string, c *component, target, address string) (any, error) {
@@ -373,6 +434,17 @@ func (w *RemoteWeavelet) createComponent(ctx context.Context, reg *codegen.Regis
 		return nil, err
 	}
 
+	// Set leadership.
+	if reg.Singleton {
+		c, err := w.getComponent(reg.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := SetLeadership(obj, c.leadership); err != nil {
+			return nil, err
+		}
+	}
+
 	// Fill ref fields.
 	if err := FillRefs(obj, func(t reflect.Type) (any, error) {
 		return w.getIntf(t, reg.Name)
@@ -434,6 +506,8 @@ func (w *RemoteWeavelet) makeStub(fullName string, reg *codegen.Registration, re
 		methods:       makeStubMethods(fullName, reg),
 		tracer:        w.tracer,
 		injectRetries: w.opts.InjectRetries,
+		injectFault:   w.opts.InjectFault,
+		recorder:      w.opts.RecordCalls,
 	}, nil
 }
 
@@ -488,6 +562,14 @@ func (w *RemoteWeavelet) UpdateComponents(req *protos.UpdateComponentsRequest) (
 				w.syslogger.Error("Failed to update", "component", shortened[i], "err", err)
 				return
 			}
+			if c.reg.Routed {
+				// Activate the routed component to receive its assignments,
+				// which determine the keys owned by this weavelet.
+				if err := w.activate(c); err != nil {
+					w.syslogger.Error("Failed to update", "component", shortened[i], "err", err)
+					return
+				}
+			}
 			w.syslogger.Debug("Updated", "component", shortened[i])
 		}()
 	}
@@ -557,9 +639,79 @@ func (w *RemoteWeavelet) UpdateRoutingInfo(req *protos.UpdateRoutingInfoRequest)
 		c.load.updateAssignment(info.Assignment)
 	}
 
+	// Update ownership.
+	if c.ownership != nil && info.Assignment != nil {
+		c.ownership.update(info.Assignment)
+	}
+
 	return &protos.UpdateRoutingInfoReply{}, nil
 }
 
+// UpdateLeadership implements the conn.WeaveletHandler interface.
+func (w *RemoteWeavelet) UpdateLeadership(req *protos.UpdateLeadershipRequest) (*protos.UpdateLeadershipReply, error) {
+	c, err := w.getComponent(req.Component)
+	if err != nil {
+		return nil, err
+	}
+	if c.leadership == nil {
+		return nil, fmt.Errorf("component %q is not a singleton", req.Component)
+	}
+	c.leadership.Update(req.Leader)
+	w.syslogger.Debug("Updated leadership", "component", logging.ShortenComponent(req.Component), "leader", req.Leader)
+	return &protos.UpdateLeadershipReply{}, nil
+}
+
+// SetLogLevel implements the conn.WeaveletHandler interface.
+func (w *RemoteWeavelet) SetLogLevel(req *protos.SetLogLevelRequest) (*protos.SetLogLevelReply, error) {
+	c, err := w.getComponent(req.Component)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.logLevel.update(req); err != nil {
+		return nil, err
+	}
+	w.syslogger.Debug("Updated log level", "component", logging.ShortenComponent(req.Component), "level", req.Level, "ttl", time.Duration(req.TtlNs))
+	return &protos.SetLogLevelReply{}, nil
+}
+
+// CallComponent implements the conn.WeaveletHandler interface.
+func (w *RemoteWeavelet) CallComponent(req *protos.CallComponentRequest) (*protos.CallComponentReply, error) {
+	c, err := w.getComponent(req.Component)
+	if err != nil {
+		return nil, err
+	}
+	intf, err := w.GetIntf(c.reg.Iface)
+	if err != nil {
+		return nil, err
+	}
+	if req.RoutingKey != "" {
+		key, err := shardKey(c.reg, req.RoutingKey)
+		if err != nil {
+			return nil, err
+		}
+		if !c.local.Read() {
+			// Route the call using the provided routing key instead of the
+			// routing key computed from the call's arguments.
+			stub, err := w.getStub(c)
+			if err != nil {
+				return nil, err
+			}
+			intf = c.reg.ClientStubFn(shardStub{stub, key}, "root")
+		}
+	}
+	timeout := time.Duration(req.TimeoutNs)
+	if timeout <= 0 {
+		timeout = defaultCallTimeout
+	}
+	ctx, cancel := context.WithTimeout(w.ctx, timeout)
+	defer cancel()
+	results, err := invokeJSON(ctx, intf, req.Method, req.Args)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.CallComponentReply{Results: results}, nil
+}
+
 // Info returns the EnvelopeInfo received from the envelope.
 func (w *RemoteWeavelet) Info() *protos.EnvelopeInfo {
 	return w.conn.EnvelopeInfo()
@@ -644,9 +796,21 @@ func (w *RemoteWeavelet) getLoggerFunction() (func(context.Context, *protos.LogE
 }
 
 // logger returns a logger for the component with the provided name. The
-// returned logger includes the provided attributes.
+// returned logger includes the provided attributes. If name is the name of a
+// hosted component, the logger honors the component's log level and limits.
 func (w *RemoteWeavelet) logger(name string, attrs ...string) *slog.Logger {
-	return slog.New(&logging.LogHandler{
+	h := w.logHandler(name, attrs...)
+	if c, ok := w.componentsByName[name]; ok {
+		h.Level = c.logLevel
+		h.Limiter = c.logLimiter
+	}
+	return slog.New(h)
+}
+
+// logHandler returns a handler for the provided component name and attributes
+// that is not subject to the component's log level and limits.
+func (w *RemoteWeavelet) logHandler(name string, attrs ...string) *logging.LogHandler {
+	return &logging.LogHandler{
 		Opts: logging.Options{
 			App:        w.Info().App,
 			Deployment: w.Info().DeploymentId,
@@ -655,7 +819,7 @@ func (w *RemoteWeavelet) logger(name string, attrs ...string) *slog.Logger {
 			Attrs:      attrs,
 		},
 		Write: w.logDst.log,
-	})
+	}
 }
 
 // listener returns the listener with the provided name.
@@ -704,6 +868,7 @@ func (w *RemoteWeavelet) listener(name string) (*listener, error) {
 	// Store the listener.
 	l := &listener{lis, reply.ProxyAddress}
 	w.listeners[name] = l
+	w.sampler.addListener(name, lis.Addr())
 	return l, nil
 }
 
@@ -0,0 +1,335 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultListenerTraceRate is the maximum number of traces per second started
+// by HTTP requests to a listener without a configured rate.
+const defaultListenerTraceRate = 1.0
+
+// maxUnsampledSpans is the maximum number of spans of unsampled traces that
+// an errorSpanProcessor buffers. Spans beyond the limit are dropped.
+const maxUnsampledSpans = 10000
+
+// sampler is an sdktrace.Sampler that implements a protos.TraceSampling
+// policy. See protos.TraceSampling for details.
+type sampler struct {
+	config *protos.TraceSampling
+	ratio  sdktrace.Sampler // samples new traces with probability config.Ratio
+	spans  *tokenBucket     // limits sampled spans, or nil for no limit
+
+	mu        sync.Mutex
+	listeners map[string]net.Addr     // listener addresses, by name
+	rates     map[string]*tokenBucket // listener rate limits, by name
+}
+
+var _ sdktrace.Sampler = &sampler{}
+
+// newSampler returns a sampler that implements the provided policy, or the
+// default policy if config is nil.
+func newSampler(config *protos.TraceSampling) *sampler {
+	if config == nil {
+		config = &protos.TraceSampling{Ratio: 1}
+	}
+	s := &sampler{
+		config:    config,
+		ratio:     sdktrace.TraceIDRatioBased(config.Ratio),
+		listeners: map[string]net.Addr{},
+		rates:     map[string]*tokenBucket{},
+	}
+	if config.MaxSpansPerSecond > 0 {
+		s.spans = newTokenBucket(config.MaxSpansPerSecond)
+	}
+	return s
+}
+
+// addListener registers the address of the named listener, so that traces
+// started by HTTP requests to the listener are sampled at the listener's rate.
+func (s *sampler) addListener(name string, addr net.Addr) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners[name] = addr
+}
+
+// ShouldSample implements the sdktrace.Sampler interface.
+func (s *sampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	parent := trace.SpanContextFromContext(p.ParentContext)
+	now := time.Now()
+	var sampled bool
+	if parent.IsValid() {
+		sampled = parent.IsSampled()
+		if sampled && s.spans != nil {
+			// Never drop a span of a sampled trace, but count it.
+			s.spans.take(now)
+		}
+	} else {
+		sampled = s.sampleTrace(p, now)
+	}
+
+	result := sdktrace.SamplingResult{
+		Decision:   sdktrace.Drop,
+		Tracestate: parent.TraceState(),
+	}
+	switch {
+	case sampled:
+		result.Decision = sdktrace.RecordAndSample
+	case s.config.AlwaysSampleErrors:
+		// Record the span, so that an errorSpanProcessor can export it if the
+		// trace has an error.
+		result.Decision = sdktrace.RecordOnly
+	}
+	return result
+}
+
+// sampleTrace returns whether to sample the new trace started by the span
+// with the provided parameters.
+func (s *sampler) sampleTrace(p sdktrace.SamplingParameters, now time.Time) bool {
+	if s.ratio.ShouldSample(p).Decision == sdktrace.Drop {
+		return false
+	}
+	if rate := s.listenerRate(p.ParentContext); rate != nil && !rate.allow(now) {
+		return false
+	}
+	if s.spans != nil && !s.spans.allow(now) {
+		return false
+	}
+	return true
+}
+
+// listenerRate returns the rate limit of the listener that received the HTTP
+// request in ctx, or nil if ctx doesn't belong to an HTTP request.
+func (s *sampler) listenerRate(ctx context.Context) *tokenBucket {
+	local, ok := ctx.Value(http.LocalAddrContextKey).(net.Addr)
+	if !ok {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Requests to addresses that don't belong to a listener, if any, are
+	// rate limited per address.
+	name := local.String()
+	for listener, addr := range s.listeners {
+		if sameAddr(addr, local) {
+			name = listener
+			break
+		}
+	}
+	rate, ok := s.rates[name]
+	if !ok {
+		r, ok := s.config.ListenerRates[name]
+		if !ok {
+			r = defaultListenerTraceRate
+		}
+		rate = newTokenBucket(r)
+		s.rates[name] = rate
+	}
+	return rate
+}
+
+// sameAddr returns whether a connection with the provided local address was
+// accepted by a listener with the provided address.
+func sameAddr(listener, local net.Addr) bool {
+	l, ok := listener.(*net.TCPAddr)
+	if !ok {
+		return listener.String() == local.String()
+	}
+	c, ok := local.(*net.TCPAddr)
+	if !ok || l.Port != c.Port {
+		return false
+	}
+	return l.IP.IsUnspecified() || l.IP.Equal(c.IP)
+}
+
+// Description implements the sdktrace.Sampler interface.
+func (s *sampler) Description() string {
+	return fmt.Sprintf("ServiceWeaverSampler{ratio=%v,listeners=%v,errors=%v,spans=%v}",
+		s.config.Ratio, s.config.ListenerRates, s.config.AlwaysSampleErrors, s.config.MaxSpansPerSecond)
+}
+
+// tokenBucket is a token bucket rate limiter.
+type tokenBucket struct {
+	rate  float64 // tokens per second
+	burst float64 // maximum number of tokens
+
+	mu     sync.Mutex
+	tokens float64   // available tokens; may be negative
+	last   time.Time // time tokens was last updated
+}
+
+// newTokenBucket returns a full token bucket that is refilled at the provided
+// rate of tokens per second.
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(1, rate)
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst}
+}
+
+// allow takes a token, if one is available, and returns whether it did.
+func (b *tokenBucket) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.rate <= 0 {
+		return false
+	}
+	b.refill(now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// take takes a token, even if none are available.
+func (b *tokenBucket) take(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(now)
+	b.tokens--
+}
+
+// refill adds the tokens accrued since the last refill.
+//
+// REQUIRES: b.mu is held.
+func (b *tokenBucket) refill(now time.Time) {
+	if !b.last.IsZero() && now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	if b.last.IsZero() || now.After(b.last) {
+		b.last = now
+	}
+}
+
+// errorSpanProcessor is an sdktrace.SpanProcessor that exports the recorded
+// spans of unsampled traces that have an error. Spans are buffered until the
+// span that started the trace in this process (i.e. the span without a local
+// parent) ends. At that point, if any of the buffered spans has an error
+// status, they are all exported. Otherwise, they are dropped.
+//
+// Every process runs its own errorSpanProcessor, and the decision to export
+// is not propagated between processes. If a trace spans multiple processes,
+// only the spans of the processes that observed an error are exported. For
+// example, if component A calls component B in another process, and B fails
+// but A handles the failure without an error, only B's spans are exported.
+type errorSpanProcessor struct {
+	exporter sdktrace.SpanExporter
+
+	mu       sync.Mutex
+	roots    map[trace.SpanID]trace.SpanID            // local root, by span
+	buffered map[trace.SpanID][]sdktrace.ReadOnlySpan // ended spans, by local root
+	n        int                                      // total number of buffered spans
+}
+
+var _ sdktrace.SpanProcessor = &errorSpanProcessor{}
+
+// newErrorSpanProcessor returns an errorSpanProcessor that exports spans to
+// the provided exporter.
+func newErrorSpanProcessor(exporter sdktrace.SpanExporter) *errorSpanProcessor {
+	return &errorSpanProcessor{
+		exporter: exporter,
+		roots:    map[trace.SpanID]trace.SpanID{},
+		buffered: map[trace.SpanID][]sdktrace.ReadOnlySpan{},
+	}
+}
+
+// OnStart implements the sdktrace.SpanProcessor interface.
+func (p *errorSpanProcessor) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	if s.SpanContext().IsSampled() {
+		// Sampled spans are exported by the regular span processor.
+		return
+	}
+	id := s.SpanContext().SpanID()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if parent := s.Parent(); parent.IsValid() && !parent.IsRemote() {
+		if root, ok := p.roots[parent.SpanID()]; ok {
+			p.roots[id] = root
+		}
+		// Otherwise, the span's local root has already ended.
+		return
+	}
+	p.roots[id] = id
+	p.buffered[id] = nil
+}
+
+// OnEnd implements the sdktrace.SpanProcessor interface.
+func (p *errorSpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		return
+	}
+	id := s.SpanContext().SpanID()
+
+	p.mu.Lock()
+	root, ok := p.roots[id]
+	delete(p.roots, id)
+	if !ok {
+		p.mu.Unlock()
+		return
+	}
+	spans, ok := p.buffered[root]
+	if !ok {
+		// The local root has already ended.
+		p.mu.Unlock()
+		return
+	}
+	if root != id {
+		if p.n < maxUnsampledSpans {
+			p.buffered[root] = append(spans, s)
+			p.n++
+		}
+		p.mu.Unlock()
+		return
+	}
+	delete(p.buffered, root)
+	p.n -= len(spans)
+	p.mu.Unlock()
+
+	// The local root ended. Export the buffered spans if any has an error.
+	spans = append(spans, s)
+	for _, span := range spans {
+		if span.Status().Code == codes.Error {
+			if err := p.exporter.ExportSpans(context.Background(), spans); err != nil {
+				otel.Handle(err)
+			}
+			return
+		}
+	}
+}
+
+// Shutdown implements the sdktrace.SpanProcessor interface.
+func (p *errorSpanProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+// ForceFlush implements the sdktrace.SpanProcessor interface.
+func (p *errorSpanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
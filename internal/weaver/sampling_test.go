@@ -0,0 +1,190 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newTestTracer returns a tracer that uses the provided sampler and exports
+// spans synchronously to the returned exporter.
+func newTestTracer(s *sampler) (trace.Tracer, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithSampler(s),
+	}
+	if s.config.AlwaysSampleErrors {
+		opts = append(opts, sdktrace.WithSpanProcessor(newErrorSpanProcessor(exporter)))
+	}
+	return sdktrace.NewTracerProvider(opts...).Tracer("test"), exporter
+}
+
+// countSampled starts and ends n root spans in ctx, each with a child span,
+// and returns the number of sampled root spans.
+func countSampled(tracer trace.Tracer, ctx context.Context, n int) int {
+	sampled := 0
+	for i := 0; i < n; i++ {
+		ctx, root := tracer.Start(ctx, "root")
+		_, child := tracer.Start(ctx, "child")
+		if root.SpanContext().IsSampled() != child.SpanContext().IsSampled() {
+			panic("child and parent sampled differently")
+		}
+		if root.SpanContext().IsSampled() {
+			sampled++
+		}
+		child.End()
+		root.End()
+	}
+	return sampled
+}
+
+func TestSamplerRatio(t *testing.T) {
+	for _, test := range []struct {
+		ratio float64
+		want  int
+	}{
+		{0, 0},
+		{1, 100},
+	} {
+		tracer, exporter := newTestTracer(newSampler(&protos.TraceSampling{Ratio: test.ratio}))
+		if got := countSampled(tracer, context.Background(), 100); got != test.want {
+			t.Errorf("ratio %v: got %d sampled traces, want %d", test.ratio, got, test.want)
+		}
+		if got, want := len(exporter.GetSpans()), 2*test.want; got != want {
+			t.Errorf("ratio %v: got %d exported spans, want %d", test.ratio, got, want)
+		}
+	}
+}
+
+func TestSamplerListenerRates(t *testing.T) {
+	s := newSampler(&protos.TraceSampling{
+		Ratio:         1,
+		ListenerRates: map[string]float64{"fast": 10, "off": 0},
+	})
+	addr := func(port int) net.Addr {
+		return &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: port}
+	}
+	s.addListener("fast", &net.TCPAddr{IP: net.IPv6unspecified, Port: 1})
+	s.addListener("off", addr(2))
+	s.addListener("default", addr(3))
+	tracer, _ := newTestTracer(s)
+
+	for _, test := range []struct {
+		name string
+		addr net.Addr
+		want int
+	}{
+		{"fast", addr(1), 10},
+		{"off", addr(2), 0},
+		{"default", addr(3), 1},
+		{"unknown", addr(4), 1},
+	} {
+		ctx := context.WithValue(context.Background(), http.LocalAddrContextKey, test.addr)
+		if got := countSampled(tracer, ctx, 100); got != test.want {
+			t.Errorf("%s: got %d sampled traces, want %d", test.name, got, test.want)
+		}
+	}
+
+	// Traces not started by HTTP requests are not subject to listener rates.
+	if got, want := countSampled(tracer, context.Background(), 100), 100; got != want {
+		t.Errorf("no listener: got %d sampled traces, want %d", got, want)
+	}
+}
+
+func TestSamplerMaxSpansPerSecond(t *testing.T) {
+	tracer, exporter := newTestTracer(newSampler(&protos.TraceSampling{
+		Ratio:             1,
+		MaxSpansPerSecond: 10,
+	}))
+	// Every trace has two spans, so at most five traces are sampled.
+	if got, want := countSampled(tracer, context.Background(), 100), 5; got != want {
+		t.Errorf("got %d sampled traces, want %d", got, want)
+	}
+	if got, want := len(exporter.GetSpans()), 10; got != want {
+		t.Errorf("got %d exported spans, want %d", got, want)
+	}
+}
+
+func TestSamplerAlwaysSampleErrors(t *testing.T) {
+	tracer, exporter := newTestTracer(newSampler(&protos.TraceSampling{
+		Ratio:              0,
+		AlwaysSampleErrors: true,
+	}))
+	ctx := context.Background()
+
+	// A trace without errors is dropped.
+	if got := countSampled(tracer, ctx, 10); got != 0 {
+		t.Fatalf("got %d sampled traces, want 0", got)
+	}
+	if got := len(exporter.GetSpans()); got != 0 {
+		t.Fatalf("got %d exported spans, want 0", got)
+	}
+
+	// A trace with an error is exported, once its root span ends.
+	ctx, root := tracer.Start(ctx, "root")
+	_, child := tracer.Start(ctx, "child")
+	child.RecordError(errors.New("oops"))
+	child.SetStatus(codes.Error, "oops")
+	child.End()
+	if got := len(exporter.GetSpans()); got != 0 {
+		t.Fatalf("got %d exported spans before the root ended, want 0", got)
+	}
+	root.End()
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d exported spans, want 2", len(spans))
+	}
+	for _, span := range spans {
+		if span.SpanContext.TraceID() != root.SpanContext().TraceID() {
+			t.Errorf("span %q: got trace %v, want %v", span.Name, span.SpanContext.TraceID(), root.SpanContext().TraceID())
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	b := newTokenBucket(2)
+	now := time.Now()
+	allowed := 0
+	for i := 0; i < 10; i++ {
+		if b.allow(now) {
+			allowed++
+		}
+	}
+	if got, want := allowed, 2; got != want {
+		t.Fatalf("initial burst: got %d, want %d", got, want)
+	}
+
+	// Taking tokens puts the bucket in debt.
+	b.take(now)
+	b.take(now)
+	if b.allow(now.Add(time.Second)) {
+		t.Fatal("unexpected token after one second of debt")
+	}
+	if !b.allow(now.Add(2 * time.Second)) {
+		t.Fatal("missing token after the debt was repaid")
+	}
+}
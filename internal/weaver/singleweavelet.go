@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
 	"net"
 	"net/http"
 	"os"
@@ -28,6 +29,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/ServiceWeaver/weaver/internal/callers"
 	"github.com/ServiceWeaver/weaver/internal/config"
 	"github.com/ServiceWeaver/weaver/internal/env"
 	"github.com/ServiceWeaver/weaver/internal/envelope/conn"
@@ -54,6 +56,19 @@ type SingleWeaveletOptions struct {
 	Config         string               // TOML config contents
 	Fakes          map[reflect.Type]any // component fakes, by component interface type
 	Quiet          bool                 // if true, do not print or log anything
+
+	// If CopyOnCall is true, component method arguments and results are
+	// encoded and decoded on every call, as they would be for an RPC, rather
+	// than passed by reference. This detects components that rely on sharing
+	// memory with their callers, which breaks when the components are not
+	// co-located.
+	CopyOnCall bool
+
+	// If not nil, LogWriter and SpanWriter receive every log entry and every
+	// span, in addition to the standard destinations. Spans are written
+	// synchronously when they end.
+	LogWriter  func(*protos.LogEntry)
+	SpanWriter func(*protos.TraceSpans) error
 }
 
 // SingleWeavelet is a weavelet that runs all components locally in a single
@@ -73,9 +88,12 @@ type SingleWeavelet struct {
 	createdAt    time.Time             // time at which the weavelet was created
 
 	// Logging, tracing, and metrics.
-	pp     *logging.PrettyPrinter   // pretty printer for logger
-	tracer trace.Tracer             // tracer used by all components
-	stats  *imetrics.StatsProcessor // metrics aggregator
+	pp          *logging.PrettyPrinter      // pretty printer for logger
+	logLimiters map[string]*logging.Limiter // log limits, by component name
+	logLevels   map[string]*logLevel        // log levels, by component name
+	tracer      trace.Tracer                // tracer used by all components
+	sampler     *sampler                    // samples traces
+	stats       *imetrics.StatsProcessor    // metrics aggregator
 
 	// Components and listeners.
 	mu         sync.Mutex              // guards the following fields
@@ -104,7 +122,8 @@ func NewSingleWeavelet(ctx context.Context, regs []*codegen.Registration, opts S
 	// Set up tracer.
 	deploymentId := uuid.New().String()
 	id := uuid.New().String()
-	tracer, err := singleTracer(ctx, config.App.Name, deploymentId, id)
+	sampler := newSampler(config.App.TraceSampling)
+	tracer, err := singleTracer(ctx, config.App.Name, deploymentId, id, opts.SpanWriter, sampler)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +137,14 @@ func NewSingleWeavelet(ctx context.Context, regs []*codegen.Registration, opts S
 		regsByIntf[reg.Iface] = reg
 		regsByImpl[reg.Impl] = reg
 	}
+	logLimiters, err := newLogLimiters(regs, config.App.LogLimits)
+	if err != nil {
+		return nil, err
+	}
+	logLevels, err := newLogLevels(regs, config.App.LogLevels)
+	if err != nil {
+		return nil, err
+	}
 
 	// Print rolodex card.
 	//
@@ -130,7 +157,7 @@ func NewSingleWeavelet(ctx context.Context, regs []*codegen.Registration, opts S
 		fmt.Fprint(os.Stderr, reg.Rolodex())
 	}
 
-	return &SingleWeavelet{
+	w := &SingleWeavelet{
 		regs:         regs,
 		regsByName:   regsByName,
 		regsByIntf:   regsByIntf,
@@ -141,11 +168,18 @@ func NewSingleWeavelet(ctx context.Context, regs []*codegen.Registration, opts S
 		id:           id,
 		createdAt:    time.Now(),
 		pp:           logging.NewPrettyPrinter(colors.Enabled()),
+		logLimiters:  logLimiters,
+		logLevels:    logLevels,
 		tracer:       tracer,
+		sampler:      sampler,
 		stats:        imetrics.NewStatsProcessor(),
 		components:   map[string]any{},
 		listeners:    map[string]net.Listener{},
-	}, nil
+	}
+	go reportSuppressedLogs(ctx, logLimiters, func(component string) slog.Handler {
+		return w.logHandler(component)
+	})
+	return w, nil
 }
 
 // parseSingleConfig parses the "[single]" section of a config file.
@@ -179,19 +213,34 @@ func parseSingleConfig(regs []*codegen.Registration, filename, contents string)
 		}
 	}
 
+	// Validate components in the config.
+	components := make([]string, len(regs))
+	for i, reg := range regs {
+		components[i] = reg.Name
+	}
+	if err := runtime.CheckComponentNames(config.App, components); err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
 
-// singleTracer returns a tracer for single process execution.
-func singleTracer(ctx context.Context, app, deploymentId, id string) (trace.Tracer, error) {
+// singleTracer returns a tracer for single process execution. If spanWriter
+// is not nil, it receives every span synchronously.
+func singleTracer(ctx context.Context, app, deploymentId, id string, spanWriter func(*protos.TraceSpans) error, sampler *sampler) (trace.Tracer, error) {
 	traceDB, err := traces.OpenDB(ctx, single.PerfettoFile)
 	if err != nil {
 		return nil, fmt.Errorf("cannot open Perfetto database: %w", err)
 	}
 	exporter := traceio.NewWriter(func(spans *protos.TraceSpans) error {
+		if spanWriter != nil {
+			if err := spanWriter(spans); err != nil {
+				return err
+			}
+		}
 		return traceDB.Store(ctx, app, deploymentId, spans)
 	})
-	return tracer(exporter, app, deploymentId, id), nil
+	return tracer(exporter, app, deploymentId, id, spanWriter != nil, sampler), nil
 }
 
 // GetIntf implements the Weavelet interface.
@@ -221,6 +270,13 @@ func (w *SingleWeavelet) getIntf(t reflect.Type, requester string) (any, error)
 	if err != nil {
 		return nil, err
 	}
+	if w.opts.CopyOnCall {
+		// Copy arguments and results with an uninstrumented client stub, and
+		// wrap it in a local stub, so that calls are recorded in metrics and
+		// traces as local calls.
+		client := reg.ClientStubFn(newCopyStub(reg, c), callers.Uninstrumented)
+		return reg.LocalStubFn(client, requester, w.tracer), nil
+	}
 	return reg.LocalStubFn(c, requester, w.tracer), nil
 }
 
@@ -267,6 +323,14 @@ func (w *SingleWeavelet) get(reg *codegen.Registration) (any, error) {
 		return nil, err
 	}
 
+	// Set leadership. There is only one replica of every component, so it is
+	// always the leader.
+	if reg.Singleton {
+		if err := SetLeadership(obj, NewLeadership(true)); err != nil {
+			return nil, err
+		}
+	}
+
 	// Fill ref fields.
 	if err := FillRefs(obj, func(t reflect.Type) (any, error) {
 		return w.getIntf(t, reg.Name)
@@ -290,6 +354,19 @@ func (w *SingleWeavelet) get(reg *codegen.Registration) (any, error) {
 		}
 	}
 
+	// Report ownership. There is only one replica of every component, so it
+	// owns the entire key space. We report ownership in a separate goroutine
+	// because w.mu is held.
+	if reg.Routed {
+		change := OwnershipChange{
+			Version:  1,
+			Acquired: []KeyRange{{Start: 0, End: math.MaxUint64}},
+			ShardKey: reg.ShardKeyFn,
+		}
+		// TODO(mwhittaker): Use better context.
+		go NotifyOwnership(context.Background(), obj, change)
+	}
+
 	w.components[reg.Name] = obj
 	return obj, nil
 }
@@ -315,12 +392,28 @@ func (w *SingleWeavelet) listener(name string) (net.Listener, error) {
 
 	// Store the listener.
 	w.listeners[name] = lis
+	w.sampler.addListener(name, lis.Addr())
 	return lis, err
 }
 
-// logger returns a logger for the component with the provided name.
+// logger returns a logger for the component with the provided name. The
+// logger honors the component's log level and limits.
 func (w *SingleWeavelet) logger(name string) *slog.Logger {
+	h := w.logHandler(name)
+	if level, ok := w.logLevels[name]; ok {
+		h.Level = level
+	}
+	h.Limiter = w.logLimiters[name]
+	return slog.New(h)
+}
+
+// logHandler returns a handler for the component with the provided name that
+// is not subject to the component's log level and limits.
+func (w *SingleWeavelet) logHandler(name string) *logging.LogHandler {
 	write := func(entry *protos.LogEntry) {
+		if w.opts.LogWriter != nil {
+			w.opts.LogWriter(entry)
+		}
 		msg := w.pp.Format(entry)
 		if w.opts.Quiet {
 			// Note that we format the log entry regardless of whether we print
@@ -330,7 +423,7 @@ func (w *SingleWeavelet) logger(name string) *slog.Logger {
 		}
 		fmt.Fprintln(os.Stderr, msg)
 	}
-	return slog.New(&logging.LogHandler{
+	return &logging.LogHandler{
 		Opts: logging.Options{
 			App:        w.config.App.Name,
 			Deployment: w.deploymentId,
@@ -338,7 +431,7 @@ func (w *SingleWeavelet) logger(name string) *slog.Logger {
 			Weavelet:   w.id,
 		},
 		Write: write,
-	})
+	}
 }
 
 // ServeStatus runs an HTTP status server.
@@ -361,7 +454,7 @@ func (w *SingleWeavelet) ServeStatus(ctx context.Context) error {
 	// Spawn the status server.
 	mux := http.NewServeMux()
 	mux.Handle("/debug/pprof/", http.DefaultServeMux)
-	status.RegisterServer(mux, w, noopLogger)
+	status.RegisterServer(mux, w, "", noopLogger)
 	lis, err := net.Listen("tcp", "localhost:0")
 	if err != nil {
 		return err
@@ -499,6 +592,16 @@ func (w *SingleWeavelet) Profile(_ context.Context, req *protos.GetProfileReques
 	return &protos.GetProfileReply{Data: data}, err
 }
 
+// CallComponent implements the status.Server interface.
+func (w *SingleWeavelet) CallComponent(context.Context, *protos.CallComponentRequest) (*protos.CallComponentReply, error) {
+	return nil, fmt.Errorf("calling components is not supported by weaver single")
+}
+
+// SetLogLevel implements the status.Server interface.
+func (w *SingleWeavelet) SetLogLevel(context.Context, *protos.SetLogLevelRequest) (*protos.SetLogLevelReply, error) {
+	return nil, fmt.Errorf("changing log levels is not supported by weaver single")
+}
+
 // serveHTTP serves HTTP traffic on the provided listener using the provided
 // handler. The server is shut down when then provided context is cancelled.
 func serveHTTP(ctx context.Context, lis net.Listener, handler http.Handler) error {
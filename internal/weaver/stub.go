@@ -16,6 +16,8 @@ package weaver
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/ServiceWeaver/weaver/internal/net/call"
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
@@ -29,13 +31,34 @@ type stub struct {
 	methods       []stubMethod    // per method info
 	tracer        trace.Tracer    // component tracer
 	injectRetries int             // Number of artificial retries per retriable call
+	injectFault   FaultInjector   // If not nil, injects artificial faults
+	recorder      *CallRecorder   // If not nil, records successful calls
 }
 
 type stubMethod struct {
+	name  string         // name of the remote component method
 	key   call.MethodKey // key for remote component method
 	retry bool           // Whether or not the method should be retred
 }
 
+// A Fault is an artificial failure injected into a remote method call. Faults
+// are used for testing. The zero value injects no fault.
+type Fault struct {
+	Delay       time.Duration // latency added before the call is sent
+	Error       bool          // fail the call without delivering it
+	DropReply   bool          // deliver the call, but drop its reply
+	Unavailable bool          // never deliver the call; block until ctx is done
+}
+
+// A FaultInjector returns the fault to inject into a remote call of the
+// provided component method. A FaultInjector is called once per call attempt,
+// and it must be safe for concurrent use by multiple goroutines.
+type FaultInjector func(component, method string) Fault
+
+// errInjectedFault is the error returned by a call that failed because of an
+// injected Fault. The generated stubs wrap the error in a RemoteCallError.
+var errInjectedFault = errors.New("injected fault")
+
 var _ codegen.Stub = &stub{}
 
 // Tracer implements the codegen.Stub interface.
@@ -55,12 +78,52 @@ func (s *stub) Run(ctx context.Context, method int, args []byte, shardKey uint64
 		n += s.injectRetries
 	}
 	for i := 0; i < n; i++ {
-		result, err = s.conn.Call(ctx, m.key, args, opts)
+		result, err = s.call(ctx, m, args, opts)
 		// No backoff since these retries are fake ones injected for testing.
 	}
+	if err == nil && s.recorder != nil {
+		s.recorder.Record(CallRecord{
+			Component: s.component,
+			Method:    m.name,
+			Args:      args,
+			Results:   result,
+		})
+	}
 	return
 }
 
+// call performs a single remote call, injecting faults if necessary.
+func (s *stub) call(ctx context.Context, m stubMethod, args []byte, opts call.CallOptions) ([]byte, error) {
+	if s.injectFault == nil {
+		return s.conn.Call(ctx, m.key, args, opts)
+	}
+
+	fault := s.injectFault(s.component, m.name)
+	if fault.Delay > 0 {
+		timer := time.NewTimer(fault.Delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+	switch {
+	case fault.Unavailable:
+		<-ctx.Done()
+		return nil, ctx.Err()
+	case fault.Error:
+		return nil, errInjectedFault
+	case fault.DropReply:
+		if _, err := s.conn.Call(ctx, m.key, args, opts); err != nil {
+			return nil, err
+		}
+		return nil, errInjectedFault
+	default:
+		return s.conn.Call(ctx, m.key, args, opts)
+	}
+}
+
 // makeStubMethods returns a slice of stub methods for the component methods of reg.
 func makeStubMethods(fullName string, reg *codegen.Registration) []stubMethod {
 	// Construct method info slice.
@@ -68,6 +131,7 @@ func makeStubMethods(fullName string, reg *codegen.Registration) []stubMethod {
 	methods := make([]stubMethod, n)
 	for i := 0; i < n; i++ {
 		mname := reg.Iface.Method(i).Name
+		methods[i].name = mname
 		methods[i].key = call.MakeMethodKey(fullName, mname)
 		methods[i].retry = true // Retry by default
 	}
@@ -76,3 +140,39 @@ func makeStubMethods(fullName string, reg *codegen.Registration) []stubMethod {
 	}
 	return methods
 }
+
+// copyStub is a codegen.Stub that executes method calls against a component
+// in the same process. Unlike a local stub, a copyStub encodes and decodes
+// method arguments and results, so callers and callees never share memory.
+type copyStub struct {
+	handlers []func(context.Context, []byte) ([]byte, error) // by method index
+}
+
+var _ codegen.Stub = &copyStub{}
+
+// noopTracer is the tracer used by copyStubs.
+var noopTracer = trace.NewNoopTracerProvider().Tracer("")
+
+// newCopyStub returns a new copyStub for the provided component instance.
+func newCopyStub(reg *codegen.Registration, impl any) *copyStub {
+	server := reg.ServerStubFn(impl, func(uint64, float64) {})
+	n := reg.Iface.NumMethod()
+	handlers := make([]func(context.Context, []byte) ([]byte, error), n)
+	for i := 0; i < n; i++ {
+		handlers[i] = server.GetStubFn(reg.Iface.Method(i).Name)
+	}
+	return &copyStub{handlers: handlers}
+}
+
+// Tracer implements the codegen.Stub interface.
+func (s *copyStub) Tracer() trace.Tracer {
+	// Calls are traced by the local stub that wraps the client stub.
+	return noopTracer
+}
+
+// Run implements the codegen.Stub interface.
+func (s *copyStub) Run(ctx context.Context, method int, args []byte, _ uint64) ([]byte, error) {
+	// The handler decodes args into fresh values, and the caller decodes the
+	// returned results into fresh values, so no memory is shared.
+	return s.handlers[method](ctx, args)
+}
@@ -19,6 +19,7 @@ import (
 	"os"
 
 	"github.com/ServiceWeaver/weaver/internal/traceio"
+	"github.com/ServiceWeaver/weaver/runtime/traces"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -28,12 +29,17 @@ import (
 )
 
 // tracer returns a tracer for the provided app, deploymentId, and weaveletId
-// that uses the provided exporter. The tracer is also set as the otel default.
-func tracer(exporter sdktrace.SpanExporter, app, deploymentId, weaveletId string) trace.Tracer {
-	const instrumentationLibrary = "github.com/ServiceWeaver/weaver/serviceweaver"
+// that uses the provided exporter and sampler. The tracer is also set as the
+// otel default. If sync is true, spans are exported synchronously when they
+// end, rather than in batches.
+func tracer(exporter sdktrace.SpanExporter, app, deploymentId, weaveletId string, sync bool, sampler *sampler) trace.Tracer {
 	const instrumentationVersion = "0.0.1"
-	tracerProvider := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exporter),
+	processor := sdktrace.NewBatchSpanProcessor(exporter)
+	if sync {
+		processor = sdktrace.NewSimpleSpanProcessor(exporter)
+	}
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSpanProcessor(processor),
 		sdktrace.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceNameKey.String(fmt.Sprintf("serviceweaver/%s", weaveletId)),
@@ -42,10 +48,13 @@ func tracer(exporter sdktrace.SpanExporter, app, deploymentId, weaveletId string
 			traceio.DeploymentIdTraceKey.String(deploymentId),
 			traceio.WeaveletIdTraceKey.String(weaveletId),
 		)),
-		// TODO(spetrovic): Allow the user to create new TracerProviders where
-		// they can control trace sampling and other options.
-		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())))
-	tracer := tracerProvider.Tracer(instrumentationLibrary, trace.WithInstrumentationVersion(instrumentationVersion))
+		sdktrace.WithSampler(sampler),
+	}
+	if sampler.config.AlwaysSampleErrors {
+		opts = append(opts, sdktrace.WithSpanProcessor(newErrorSpanProcessor(exporter)))
+	}
+	tracerProvider := sdktrace.NewTracerProvider(opts...)
+	tracer := tracerProvider.Tracer(traces.InstrumentationLibrary, trace.WithInstrumentationVersion(instrumentationVersion))
 
 	// Set global tracing defaults.
 	otel.SetTracerProvider(tracerProvider)
@@ -15,6 +15,7 @@
 package weaver
 
 import (
+	"context"
 	"log/slog"
 	"net"
 	"reflect"
@@ -56,4 +57,14 @@ var (
 	// GetConfig returns the config stored in the provided component
 	// implementation, or returns nil if there is no config.
 	GetConfig func(impl any) any
+
+	// SetLeadership sets the leadership of a singleton component
+	// implementation struct. impl should be a pointer to the implementation
+	// struct.
+	SetLeadership func(impl any, leadership *Leadership) error
+
+	// NotifyOwnership reports a change in ownership to a routed component
+	// implementation, if it implements weaver.OwnershipObserver. impl should
+	// be a pointer to the implementation struct.
+	NotifyOwnership func(ctx context.Context, impl any, change OwnershipChange)
 )
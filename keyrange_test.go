@@ -0,0 +1,42 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weaver
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKeyRangeContains(t *testing.T) {
+	for _, test := range []struct {
+		r    KeyRange
+		key  uint64
+		want bool
+	}{
+		{KeyRange{10, 20}, 9, false},
+		{KeyRange{10, 20}, 10, true},
+		{KeyRange{10, 20}, 19, true},
+		{KeyRange{10, 20}, 20, false},
+		{KeyRange{10, math.MaxUint64}, 9, false},
+		{KeyRange{10, math.MaxUint64}, math.MaxUint64 - 1, true},
+		{KeyRange{10, math.MaxUint64}, math.MaxUint64, true},
+		{KeyRange{0, math.MaxUint64}, 0, true},
+		{KeyRange{0, math.MaxUint64}, math.MaxUint64, true},
+	} {
+		if got := test.r.Contains(test.key); got != test.want {
+			t.Errorf("%v.Contains(%d): got %t, want %t", test.r, test.key, got, test.want)
+		}
+	}
+}
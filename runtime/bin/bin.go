@@ -45,7 +45,7 @@ func init() {
 	// the value of version.DeployerVersion. If the string is not a
 	// constant---if we try to use fmt.Sprintf, for example---it will not be
 	// embedded in a Service Weaver binary.
-	versionData = "⟦wEaVeRvErSiOn:deployer=v0.22.0⟧"
+	versionData = "⟦wEaVeRvErSiOn:deployer=v0.23.0⟧"
 }
 
 // rodata returns the read-only data section of the provided binary.
@@ -138,6 +138,16 @@ func ReadListeners(file string) ([]codegen.ComponentListeners, error) {
 	return codegen.ExtractListeners(data), nil
 }
 
+// ReadComponents reads the methods and routing of each component in the
+// specified binary.
+func ReadComponents(file string) ([]codegen.ComponentInfo, error) {
+	data, err := rodata(file)
+	if err != nil {
+		return nil, err
+	}
+	return codegen.ExtractComponents(data), nil
+}
+
 type Versions struct {
 	ModuleVersion   string         // Service Weaver library's module version
 	DeployerVersion version.SemVer // see version.DeployerVersion
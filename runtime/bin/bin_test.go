@@ -138,6 +138,61 @@ func TestReadListeners(t *testing.T) {
 	}
 }
 
+func TestReadComponents(t *testing.T) {
+	for _, test := range []struct{ os, arch string }{
+		{"linux", "amd64"},
+		{"windows", "amd64"},
+		{"darwin", "arm64"},
+	} {
+		t.Run(fmt.Sprintf("%s/%s", test.os, test.arch), func(t *testing.T) {
+			// Build the binary for os/arch.
+			d := t.TempDir()
+			binary := filepath.Join(d, "bin")
+			cmd := exec.Command("go", "build", "-o", binary, "./testprogram")
+			cmd.Env = append(os.Environ(), "GOOS="+test.os, "GOARCH="+test.arch)
+			if err := cmd.Run(); err != nil {
+				t.Fatal(err)
+			}
+
+			// Read components.
+			components, err := ReadComponents(binary)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Check that expected components and methods are found. Ignore
+			// the method hashes, which are checked by the generator tests.
+			type method struct {
+				Name    string
+				NoRetry bool
+			}
+			got := map[string][]method{}
+			for _, c := range components {
+				got[c.Component] = []method{}
+				for _, m := range c.Methods {
+					if m.Hash == "" {
+						t.Errorf("%s.%s: empty hash", c.Component, m.Name)
+					}
+					got[c.Component] = append(got[c.Component], method{m.Name, m.NoRetry})
+				}
+			}
+			pkg := func(c string) string {
+				return fmt.Sprintf("github.com/ServiceWeaver/weaver/runtime/bin/testprogram/%s", c)
+			}
+			want := map[string][]method{
+				"github.com/ServiceWeaver/weaver/Main":   {},
+				"github.com/ServiceWeaver/weaver/Logger": {{"LogBatch", false}},
+				pkg("A"):                                 {},
+				pkg("B"):                                 {{"Get", false}},
+				pkg("C"):                                 {{"Put", true}},
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Fatalf("unexpected components (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestExtractVersion(t *testing.T) {
 	for _, want := range []version.SemVer{
 		{Major: 4, Minor: 5, Patch: 6},
@@ -24,8 +24,16 @@ import (
 //go:generate ../../../cmd/weaver/weaver generate
 
 type A interface{}
-type B interface{}
-type C interface{}
+
+type B interface {
+	Get(context.Context, string) (int, error)
+}
+
+type C interface {
+	Put(context.Context, string, int) error
+}
+
+var _ weaver.NotRetriable = C.Put
 
 type app struct {
 	weaver.Implements[weaver.Main]
@@ -48,9 +56,13 @@ type b struct {
 	weaver.Implements[B]
 }
 
+func (*b) Get(context.Context, string) (int, error) { return 0, nil }
+
 type c struct {
 	weaver.Listener `weaver:"cLis"`
 	weaver.Implements[C]
 }
 
+func (*c) Put(context.Context, string, int) error { return nil }
+
 func main() {}
@@ -36,9 +36,11 @@ var (
 
 // RegisterSerializable records type T as serializable. This is needed to
 // instantiate the appropriate concrete type when an interface is sent over the
-// wire (currently only used for AutoMarshal errors returned from remote method
-// calls). The registration is automatically done by generated code for custom
-// error structs that embed weaver.AutoMarshal.
+// wire (e.g., AutoMarshal errors returned from remote method calls, or values
+// of named interface types passed to remote methods). The registration is
+// automatically done by generated code for custom error structs that embed
+// weaver.AutoMarshal, and for structs that embed weaver.AutoMarshal and
+// implement a named interface type serialized in the same package.
 func RegisterSerializable[T AutoMarshal]() {
 	var value T
 	t := reflect.TypeOf(value)
@@ -0,0 +1,149 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// The methods and routing of every component are embedded in the generated
+// binary as specially formatted strings. These strings can be extracted from
+// the binary to compare the APIs of two binaries without having to execute
+// them.
+//
+// The information about a component is represented by a string fragment that
+// looks like:
+// ⟦checksum:wEaVeRcOmPoNeNt:component→router;methods;fields⟧
+//
+// checksum is the first 8 bytes of the hex encoding of the SHA-256 of the
+// string "wEaVeRcOmPoNeNt:component→router;methods;fields"; component is the
+// fully qualified component type name; router is empty if the component is not
+// routed, or the hash of its routing otherwise; methods is a comma-separated
+// list of entries of the form name=hash, where hash is the hash of the
+// method's signature. Entries of methods that should not be retried have a
+// "/noretry" suffix. fields is a comma-separated list of entries of the form
+// field=hash, one for every field of a tagged struct used by the methods,
+// where field is query-escaped. If there are no such fields, ";fields" is
+// omitted.
+
+// ComponentInfo describes the methods and routing of a component.
+type ComponentInfo struct {
+	// Fully qualified component type name, e.g.,
+	//   github.com/ServiceWeaver/weaver/Main.
+	Component string
+
+	// If the component is routed, Router is a hash of the routing key type
+	// and the set of routed methods. Otherwise, Router is empty.
+	Router string
+
+	// The component's methods, sorted by name.
+	Methods []MethodInfo
+
+	// The fields of the structs that use tagged encoding (i.e., that have
+	// weaver:"<tag>" field tags) in the component's method signatures. The
+	// fields are keyed by "<struct name>#<tag>". The values are hashes of the
+	// field signatures. Method hashes describe these structs by name only,
+	// so that fields can be added and removed without changing the hashes.
+	TaggedFields map[string]string
+}
+
+// MethodInfo describes a component method.
+type MethodInfo struct {
+	Name    string // method name
+	Hash    string // hash of the serialization of the method's arguments and results
+	NoRetry bool   // true if the method should not be retried
+}
+
+// HashSignature returns the hash of a signature. See ComponentInfo and
+// MethodInfo.
+func HashSignature(signature string) string {
+	sum := sha256.Sum256([]byte(signature))
+	return fmt.Sprintf("%0x", sum)[:8]
+}
+
+// MakeComponentString returns a string that should be emitted into generated
+// code to represent the methods and routing of a component.
+func MakeComponentString(info ComponentInfo) string {
+	// Generate a stable encoding.
+	sorted := append([]MethodInfo(nil), info.Methods...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	methods := make([]string, len(sorted))
+	for i, m := range sorted {
+		methods[i] = fmt.Sprintf("%s=%s", m.Name, m.Hash)
+		if m.NoRetry {
+			methods[i] += "/noretry"
+		}
+	}
+	str := fmt.Sprintf("%s;%s", info.Router, strings.Join(methods, ","))
+	if len(info.TaggedFields) > 0 {
+		fields := make([]string, 0, len(info.TaggedFields))
+		for field, hash := range info.TaggedFields {
+			fields = append(fields, fmt.Sprintf("%s=%s", url.QueryEscape(field), hash))
+		}
+		sort.Strings(fields)
+		str += ";" + strings.Join(fields, ",")
+	}
+	return fmt.Sprintf("⟦%s:wEaVeRcOmPoNeNt:%s→%s⟧\n",
+		checksumComponent(info.Component, str), info.Component, str)
+}
+
+// ExtractComponents returns the components corresponding to
+// MakeComponentString() results that occur in data.
+func ExtractComponents(data []byte) []ComponentInfo {
+	var results []ComponentInfo
+	re := regexp.MustCompile(`⟦([0-9a-fA-F]+):wEaVeRcOmPoNeNt:([a-zA-Z0-9\-.~_/]*?)→([0-9a-fA-F]*);([\p{L}\p{Nd}_,=/]*)(;[a-zA-Z0-9\-.~_%+,=]*)?⟧`)
+	for _, m := range re.FindAllSubmatch(data, -1) {
+		if len(m) != 6 {
+			continue
+		}
+		sum, component, router, methods, fields := string(m[1]), string(m[2]), string(m[3]), string(m[4]), string(m[5])
+		if sum != checksumComponent(component, router+";"+methods+fields) {
+			continue
+		}
+		info := ComponentInfo{Component: component, Router: router}
+		if methods != "" {
+			for _, entry := range strings.Split(methods, ",") {
+				var method MethodInfo
+				entry, method.NoRetry = strings.CutSuffix(entry, "/noretry")
+				method.Name, method.Hash, _ = strings.Cut(entry, "=")
+				info.Methods = append(info.Methods, method)
+			}
+		}
+		if fields, ok := strings.CutPrefix(fields, ";"); ok {
+			info.TaggedFields = map[string]string{}
+			for _, entry := range strings.Split(fields, ",") {
+				field, hash, _ := strings.Cut(entry, "=")
+				if unescaped, err := url.QueryUnescape(field); err == nil {
+					info.TaggedFields[unescaped] = hash
+				}
+			}
+		}
+		results = append(results, info)
+	}
+	// Generate a stable list.
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Component < results[j].Component
+	})
+	return results
+}
+
+func checksumComponent(component, str string) string {
+	return HashSignature(fmt.Sprintf("wEaVeRcOmPoNeNt:%s→%s", component, str))
+}
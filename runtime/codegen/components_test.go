@@ -0,0 +1,47 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+)
+
+func TestComponents(t *testing.T) {
+	c := codegen.ComponentInfo{Component: "c", Router: "0123abcd", Methods: []codegen.MethodInfo{
+		{Name: "Get", Hash: "aaaaaaaa"},
+		{Name: "Put", Hash: "bbbbbbbb", NoRetry: true},
+	}}
+	b := codegen.ComponentInfo{Component: "b"}
+	a := codegen.ComponentInfo{Component: "a", Methods: []codegen.MethodInfo{
+		{Name: "Ping", Hash: "cccccccc"},
+	}, TaggedFields: map[string]string{
+		"example.com/foo.Pair#1":             "dddddddd",
+		"example.com/foo.Pair[int,string]#2": "eeeeeeee",
+	}}
+	data := codegen.MakeComponentString(c) + codegen.MakeComponentString(b) + codegen.MakeComponentString(a)
+	t.Log(data)
+
+	// Corrupted strings are ignored.
+	data += "⟦00000000:wEaVeRcOmPoNeNt:d→;M=dddddddd⟧\n"
+
+	got := codegen.ExtractComponents([]byte(data))
+	want := []codegen.ComponentInfo{a, b, c}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("ExtractComponents: expecting %v, got %v", want, got)
+	}
+}
@@ -43,12 +43,13 @@ func (d decoderError) Unwrap() error {
 
 // Decoder deserializes data from a byte slice data in the expected results.
 type Decoder struct {
-	data []byte
+	data  []byte
+	depth int // Current nesting depth (see BeginNested).
 }
 
 // NewDecoder instantiates a new Decoder for a given byte slice.
 func NewDecoder(data []byte) *Decoder {
-	return &Decoder{data}
+	return &Decoder{data: data}
 }
 
 // Empty returns true iff all bytes in d have been consumed.
@@ -199,6 +200,33 @@ func (d *Decoder) Bytes() []byte {
 	return d.Read(int(n))
 }
 
+// Field decodes the next field of a struct that uses tagged encoding (see
+// Encoder.BeginField). It returns the field's tag and a decoder for the
+// field's value. A zero tag is returned when there are no more fields.
+func (d *Decoder) Field() (uint32, *Decoder) {
+	tag := d.Uint32()
+	if tag == 0 {
+		return 0, nil
+	}
+	n := d.Uint32()
+	return tag, &Decoder{data: d.Read(int(n)), depth: d.depth}
+}
+
+// BeginNested begins the decoding of a value nested inside a value of a
+// recursive type. Every call to BeginNested must be paired with a call to
+// EndNested. Panics if the nesting depth exceeds MaxNesting.
+func (d *Decoder) BeginNested() {
+	d.depth++
+	if d.depth > MaxNesting {
+		panic(makeDecodeError("unable to decode value nested more than %d levels deep", MaxNesting))
+	}
+}
+
+// EndNested ends the decoding of a value started by BeginNested.
+func (d *Decoder) EndNested() {
+	d.depth--
+}
+
 // Len attempts to decode an int32.
 //
 // Panics if the result is negative (except -1).
@@ -259,7 +287,7 @@ func (d *Decoder) Interface() any {
 	defer typesMu.Unlock()
 	t, ok := types[key]
 	if !ok {
-		panic(fmt.Sprintf("received value for non-registered type %q", key))
+		panic(makeDecodeError("received value for non-registered type %q", key))
 	}
 
 	// Allocate space for the value.
@@ -271,7 +299,7 @@ func (d *Decoder) Interface() any {
 	}
 	am, ok := ptr.Interface().(AutoMarshal)
 	if !ok {
-		panic(fmt.Sprintf("received value for non-serializable type %v", t))
+		panic(makeDecodeError("received value for non-serializable type %v", t))
 	}
 	am.WeaverUnmarshal(d)
 
@@ -282,6 +310,34 @@ func (d *Decoder) Interface() any {
 	return result.Interface()
 }
 
+// DecodeInterfaceValue decodes a value encoded by Encoder.InterfaceValue. T
+// must be an interface type, and the decoded value must implement T.
+func DecodeInterfaceValue[T any](d *Decoder) T {
+	// A value of an interface type may hold a value that holds a value of
+	// the same interface type.
+	d.BeginNested()
+	defer d.EndNested()
+
+	var value any
+	switch tag := d.Uint8(); tag {
+	case nilInterface:
+		var zero T
+		return zero
+	case interfaceVal:
+		value = d.Interface()
+	case interfacePtr:
+		value = pointee(d.Interface())
+	default:
+		panic(makeDecodeError("invalid interface value tag %d", tag))
+	}
+	t, ok := value.(T)
+	if !ok {
+		var zero T
+		panic(makeDecodeError("received value of type %T which does not implement %v", value, reflect.TypeOf(&zero).Elem()))
+	}
+	return t
+}
+
 // decodedError is an error used for non-serializable decoded errors.
 // It supports Error() by returning the Error() string precomputed at
 // the send. It partially supports Is() by comparing the string
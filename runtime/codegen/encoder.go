@@ -43,8 +43,18 @@ func (e encoderError) Unwrap() error {
 type Encoder struct {
 	data  []byte    // Contains the serialized arguments.
 	space [100]byte // Prellocated buffer to avoid allocations for small size arguments.
+	depth int       // Current nesting depth (see BeginNested).
 }
 
+// MaxNesting is the maximum nesting depth of a value that can be encoded or
+// decoded. Values of recursive types (e.g., type Tree struct { Children
+// []*Tree }) are encoded and decoded by recursive functions, and every
+// recursive call increases the nesting depth. The limit prevents deeply
+// nested or malicious values from exhausting the stack, and prevents cyclic
+// values (e.g., a linked list whose last node points to its first node) from
+// being encoded forever.
+const MaxNesting = 100_000
+
 func NewEncoder() *Encoder {
 	var enc Encoder
 	enc.data = enc.space[:0] // Arrange to use builtin buffer
@@ -233,6 +243,53 @@ func (e *Encoder) Bytes(arg []byte) {
 	copy(data[4:], arg)
 }
 
+// BeginField begins encoding the field with the provided non-zero tag of a
+// struct that uses tagged encoding. The field's value should be encoded next,
+// followed by a call to EndField with the value returned by BeginField.
+//
+// A struct that uses tagged encoding is encoded as a sequence of fields
+// terminated by a zero tag (see EndFields). Every field is encoded as its tag,
+// followed by the length of the field's value and the encoded value itself.
+// This allows decoders to skip fields with unknown tags.
+func (e *Encoder) BeginField(tag uint32) int {
+	if tag == 0 {
+		panic(makeEncodeError("unable to encode field; zero tag"))
+	}
+	e.Uint32(tag)
+	start := len(e.data)
+	e.Grow(4)
+	return start
+}
+
+// EndField ends the encoding of a field started by BeginField.
+func (e *Encoder) EndField(start int) {
+	n := len(e.data) - start - 4
+	if n > math.MaxUint32 {
+		panic(makeEncodeError("unable to encode field; length doesn't fit in 4 bytes"))
+	}
+	binary.LittleEndian.PutUint32(e.data[start:], uint32(n))
+}
+
+// EndFields ends the encoding of a struct that uses tagged encoding.
+func (e *Encoder) EndFields() {
+	e.Uint32(0)
+}
+
+// BeginNested begins the encoding of a value nested inside a value of a
+// recursive type. Every call to BeginNested must be paired with a call to
+// EndNested. Panics if the nesting depth exceeds MaxNesting.
+func (e *Encoder) BeginNested() {
+	e.depth++
+	if e.depth > MaxNesting {
+		panic(makeEncodeError("unable to encode value nested more than %d levels deep; the value may be cyclic", MaxNesting))
+	}
+}
+
+// EndNested ends the encoding of a value started by BeginNested.
+func (e *Encoder) EndNested() {
+	e.depth--
+}
+
 // Len attempts to encode l as an int32.
 //
 // Panics if l is bigger than an int32 or a negative length (except -1).
@@ -335,3 +392,36 @@ func (e *Encoder) Interface(value AutoMarshal) {
 	e.String(typeKey(value))
 	value.WeaverMarshal(e)
 }
+
+// Encodings of a value of an interface type (see InterfaceValue).
+const (
+	nilInterface uint8 = 0
+	interfaceVal uint8 = 1 // <interfaceVal,typeKey,serial>
+	interfacePtr uint8 = 2 // <interfacePtr,typeKey,serial>; a pointer to the value was encoded
+)
+
+// InterfaceValue encodes a value of an interface type. The value must be nil,
+// or its concrete type T or *T must have been registered using
+// RegisterSerializable.
+func (e *Encoder) InterfaceValue(value any) {
+	// A value of an interface type may hold a value that holds a value of
+	// the same interface type.
+	e.BeginNested()
+	defer e.EndNested()
+
+	if value == nil {
+		e.Uint8(nilInterface)
+		return
+	}
+	if am, ok := value.(AutoMarshal); ok {
+		e.Uint8(interfaceVal)
+		e.Interface(am)
+		return
+	}
+	if am, ok := pointerTo(value).(AutoMarshal); ok {
+		e.Uint8(interfacePtr)
+		e.Interface(am)
+		return
+	}
+	panic(makeEncodeError("unable to encode interface value of type %T; the type is not serializable", value))
+}
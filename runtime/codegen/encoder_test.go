@@ -139,7 +139,7 @@ func TestErrorDecUnableToRead(t *testing.T) {
 		enc := newEncoder()
 		enc.Int(12345)
 
-		dec := Decoder{enc.data}
+		dec := Decoder{data: enc.data}
 		dec.Int()
 		dec.Bool()
 	})
@@ -156,7 +156,7 @@ func TestErrorUnableToDecBool(t *testing.T) {
 		enc := newEncoder()
 		enc.Int(123)
 
-		dec := Decoder{enc.data}
+		dec := Decoder{data: enc.data}
 		dec.Bool()
 	})
 	if !strings.Contains(err.Error(), "unable to decode bool") {
@@ -172,7 +172,7 @@ func TestErrorUnableToDecBytes(t *testing.T) {
 		enc := newEncoder()
 		enc.Int(-10)
 
-		dec := Decoder{enc.data}
+		dec := Decoder{data: enc.data}
 		dec.Bytes()
 	})
 	if !strings.Contains(err.Error(), "unable to decode bytes; expected length") {
@@ -293,6 +293,93 @@ func TestCyclicError(t *testing.T) {
 	}
 }
 
+func TestInterfaceValues(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		val  error
+	}{
+		{"nil", nil},
+		{"value", customTestError{"x"}},
+		{"ptr", &alternateError{"y"}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			enc := newEncoder()
+			enc.InterfaceValue(c.val)
+			dec := Decoder{data: enc.data}
+			got := DecodeInterfaceValue[error](&dec)
+			if !dec.Empty() {
+				t.Fatalf("leftover bytes in decoder")
+			}
+			if !reflect.DeepEqual(got, c.val) {
+				t.Fatalf("got %#v, want %#v", got, c.val)
+			}
+		})
+	}
+}
+
+func TestInterfaceValueErrors(t *testing.T) {
+	// Encoding a value of an unregistered type fails.
+	if err := func() (err error) {
+		defer func() { err = CatchPanics(recover()) }()
+		enc := newEncoder()
+		enc.InterfaceValue(errors.New("not serializable"))
+		return nil
+	}(); err == nil {
+		t.Fatal("unexpected success encoding unregistered type")
+	}
+
+	// Decoding a value that doesn't implement the interface fails.
+	enc := newEncoder()
+	enc.InterfaceValue(customTestError{"x"})
+	if err := func() (err error) {
+		defer func() { err = CatchPanics(recover()) }()
+		dec := Decoder{data: enc.data}
+		DecodeInterfaceValue[fmt.Stringer](&dec)
+		return nil
+	}(); err == nil {
+		t.Fatal("unexpected success decoding value that doesn't implement fmt.Stringer")
+	}
+}
+
+func TestNestingLimit(t *testing.T) {
+	nest := func(begin func()) (err error) {
+		defer func() { err = CatchPanics(recover()) }()
+		for i := 0; i <= MaxNesting; i++ {
+			begin()
+		}
+		return nil
+	}
+
+	enc := newEncoder()
+	if err := nest(enc.BeginNested); err == nil {
+		t.Error("unexpected success encoding deeply nested value")
+	}
+
+	// The depth of a decoder is inherited by the decoders of tagged fields.
+	enc = newEncoder()
+	start := enc.BeginField(1)
+	enc.EndField(start)
+	dec := &Decoder{data: enc.data}
+	for i := 0; i < MaxNesting; i++ {
+		dec.BeginNested()
+	}
+	_, field := dec.Field()
+	if err := func() (err error) {
+		defer func() { err = CatchPanics(recover()) }()
+		field.BeginNested()
+		return nil
+	}(); err == nil {
+		t.Error("unexpected success decoding deeply nested value")
+	}
+
+	// Balanced calls never fail.
+	enc = newEncoder()
+	for i := 0; i < 2*MaxNesting; i++ {
+		enc.BeginNested()
+		enc.EndNested()
+	}
+}
+
 // encode serializes args using the encoder enc.
 func encode(enc *Encoder, args []interface{}) {
 	for _, elem := range args {
@@ -398,3 +485,45 @@ func decode(dec *Decoder, out []interface{}) []interface{} {
 	}
 	return results
 }
+
+func TestTaggedFields(t *testing.T) {
+	enc := NewEncoder()
+	start := enc.BeginField(1)
+	enc.String("one")
+	enc.EndField(start)
+	start = enc.BeginField(7)
+	enc.Int(7)
+	enc.Float64(7.0)
+	enc.EndField(start)
+	start = enc.BeginField(2)
+	enc.Bool(true)
+	enc.EndField(start)
+	enc.EndFields()
+
+	// Decode the fields, skipping field 7.
+	dec := NewDecoder(enc.Data())
+	var tags []uint32
+	for {
+		tag, field := dec.Field()
+		if tag == 0 {
+			break
+		}
+		tags = append(tags, tag)
+		switch tag {
+		case 1:
+			if got, want := field.String(), "one"; got != want {
+				t.Errorf("field 1: got %q, want %q", got, want)
+			}
+		case 2:
+			if got, want := field.Bool(), true; got != want {
+				t.Errorf("field 2: got %v, want %v", got, want)
+			}
+		}
+	}
+	if !dec.Empty() {
+		t.Error("decoder not empty")
+	}
+	if diff := cmp.Diff([]uint32{1, 7, 2}, tags); diff != "" {
+		t.Errorf("tags (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// The HTTP/JSON gateways generated by "weaver generate" serve every method of
+// a component at "POST /<Component>/<Method>". The request body is a JSON
+// array of the method's arguments, excluding the context. The response body
+// is a JSON array of the method's results, excluding the error. If the method
+// returns an error, the response has status 500 and its body is a JSON object
+// of the form {"error": "<message>"}. Request bodies larger than 10 MiB are
+// rejected with status 413.
+//
+// ReadGatewayArgs and WriteGatewayResults are called by the generated
+// gateways.
+
+// maxGatewayRequestBytes is the maximum size of a gateway request body.
+const maxGatewayRequestBytes = 10 << 20 // 10 MiB
+
+// GatewayError is the body of a gateway response for a failed call.
+type GatewayError struct {
+	Error string `json:"error"`
+}
+
+// ReadGatewayArgs decodes the JSON array in the body of r into args, which
+// must be pointers. If the request is malformed or its body is too large,
+// ReadGatewayArgs writes an error response to w and returns false.
+func ReadGatewayArgs(w http.ResponseWriter, r *http.Request, args ...any) bool {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeGatewayError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return false
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGatewayRequestBytes))
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.As(err, new(*http.MaxBytesError)) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeGatewayError(w, status, fmt.Errorf("read arguments: %w", err))
+		return false
+	}
+	if len(args) == 0 && len(bytes.TrimSpace(body)) == 0 {
+		// Allow an empty body for methods without arguments. Note that the
+		// body is read, rather than checking r.ContentLength, which is -1
+		// for chunked requests.
+		return true
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		writeGatewayError(w, http.StatusBadRequest, fmt.Errorf("decode arguments: %w", err))
+		return false
+	}
+	if len(raw) != len(args) {
+		writeGatewayError(w, http.StatusBadRequest, fmt.Errorf("got %d arguments, want %d", len(raw), len(args)))
+		return false
+	}
+	for i, arg := range args {
+		if err := json.Unmarshal(raw[i], arg); err != nil {
+			writeGatewayError(w, http.StatusBadRequest, fmt.Errorf("decode argument %d: %w", i, err))
+			return false
+		}
+	}
+	return true
+}
+
+// WriteGatewayResults writes the results of a call to w. If err is not nil,
+// the results are ignored and an error response is written instead.
+func WriteGatewayResults(w http.ResponseWriter, err error, results ...any) {
+	if err != nil {
+		writeGatewayError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if results == nil {
+		results = []any{}
+	}
+	data, err := json.Marshal(results)
+	if err != nil {
+		writeGatewayError(w, http.StatusInternalServerError, fmt.Errorf("encode results: %w", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// WriteGatewayOpenAPI writes the provided OpenAPI description of a gateway
+// to w.
+func WriteGatewayOpenAPI(w http.ResponseWriter, r *http.Request, openapi string) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeGatewayError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(openapi))
+}
+
+// writeGatewayError writes an error response to w.
+func writeGatewayError(w http.ResponseWriter, status int, err error) {
+	data, _ := json.Marshal(GatewayError{Error: err.Error()})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+)
+
+func TestReadGatewayArgs(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		body       io.Reader
+		chunked    bool // send the body with an unknown length
+		nargs      int
+		wantOK     bool
+		wantStatus int
+	}{
+		{"Args", strings.NewReader(`[1, "a"]`), false, 2, true, http.StatusOK},
+		{"EmptyBody", strings.NewReader(""), false, 0, true, http.StatusOK},
+		{"EmptyChunkedBody", strings.NewReader(""), true, 0, true, http.StatusOK},
+		{"EmptyArray", strings.NewReader("[]"), true, 0, true, http.StatusOK},
+		{"MissingArgs", strings.NewReader(""), true, 2, false, http.StatusBadRequest},
+		{"WrongCount", strings.NewReader(`[1]`), false, 2, false, http.StatusBadRequest},
+		{"Malformed", strings.NewReader(`[1,`), false, 2, false, http.StatusBadRequest},
+		{"TooLarge", strings.NewReader("[" + strings.Repeat(" ", 11<<20) + "]"), true, 0, false, http.StatusRequestEntityTooLarge},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/Foo/Bar", test.body)
+			if test.chunked {
+				r.ContentLength = -1
+			}
+			w := httptest.NewRecorder()
+			var x int
+			var y string
+			args := []any{&x, &y}[:test.nargs]
+			if got := codegen.ReadGatewayArgs(w, r, args...); got != test.wantOK {
+				t.Fatalf("ReadGatewayArgs: got %v, want %v (response %q)", got, test.wantOK, w.Body.String())
+			}
+			if got := w.Code; got != test.wantStatus {
+				t.Fatalf("status: got %d, want %d", got, test.wantStatus)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package codegen
 import (
 	"time"
 
+	"github.com/ServiceWeaver/weaver/internal/callers"
 	"github.com/ServiceWeaver/weaver/metrics"
 )
 
@@ -75,6 +76,10 @@ type MethodMetrics struct {
 
 // MethodMetricsFor returns metrics for the specified method.
 func MethodMetricsFor(labels MethodLabels) *MethodMetrics {
+	if labels.Caller == callers.Uninstrumented {
+		// Calls made by uninstrumented client stubs are not recorded.
+		return &MethodMetrics{}
+	}
 	return &MethodMetrics{
 		remote:       labels.Remote,
 		count:        methodCounts.Get(labels),
@@ -98,6 +103,10 @@ func (m *MethodMetrics) Begin() MethodCallHandle {
 
 // End ends metric update recording for a call to method m.
 func (m *MethodMetrics) End(h MethodCallHandle, failed bool, requestBytes, replyBytes int) {
+	if m.count == nil {
+		// See MethodMetricsFor.
+		return
+	}
 	latency := time.Since(h.start).Microseconds()
 	m.count.Inc()
 	if failed {
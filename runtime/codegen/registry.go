@@ -63,9 +63,17 @@ type Registration struct {
 	Iface     reflect.Type // interface type for the component
 	Impl      reflect.Type // implementation type (struct)
 	Routed    bool         // True if calls to this component should be routed
+	Singleton bool         // True if only one replica should be active at a time
 	Listeners []string     // the names of any weaver.Listeners
 	NoRetry   []int        // indices of methods that should not be retried
 
+	// For routed components, RoutingKey is the type of the routing key, and
+	// ShardKeyFn returns the shard key of a routing key of that type. Calls
+	// routed by a routing key are sent to the replica that owns its shard
+	// key.
+	RoutingKey reflect.Type
+	ShardKeyFn func(key any) uint64
+
 	// Functions that return different types of stubs.
 	LocalStubFn   func(impl any, caller string, tracer trace.Tracer) any
 	ClientStubFn  func(stub Stub, caller string) any
@@ -73,7 +81,9 @@ type Registration struct {
 	ReflectStubFn func(func(method string, ctx context.Context, args []any, returns []any) error) any
 
 	// RefData holds a string containing the result of MakeEdgeString(Name, Dst)
-	// for all components named Dst used by this component.
+	// for all components named Dst used by this component, followed by the
+	// results of MakeListenersString and MakeComponentString for this
+	// component.
 	RefData string
 }
 
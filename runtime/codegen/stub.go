@@ -17,9 +17,15 @@ package codegen
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
+// ComponentTraceKey is the key of the span attribute that records the full
+// name (e.g., "github.com/my/app/cart/Cart") of the component whose method is
+// traced by a span.
+const ComponentTraceKey = attribute.Key("serviceweaver.component")
+
 // A Stub allows a Service Weaver component in one process to invoke methods
 // via RPC on a Service Weaver component in a different process.
 type Stub interface {
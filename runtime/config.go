@@ -16,6 +16,7 @@ package runtime
 
 import (
 	"fmt"
+	"log/slog"
 	"path/filepath"
 	"strings"
 	"time"
@@ -96,21 +97,58 @@ func ParseConfigSection(key, shortKey string, sections map[string]string, dst an
 	return nil
 }
 
-func extractApp(file string, config *protos.AppConfig) error {
-	const appKey = "github.com/ServiceWeaver/weaver"
-	const shortAppKey = "serviceweaver"
+// appKey and shortAppKey are the keys of the config section that holds the
+// common Service Weaver application configuration.
+const (
+	appKey      = "github.com/ServiceWeaver/weaver"
+	shortAppKey = "serviceweaver"
+)
 
-	// appConfig holds the data from under appKey in the TOML config.
-	// It matches the contents of the Config proto.
-	type appConfig struct {
-		Name     string
-		Binary   string
-		Args     []string
-		Env      []string
-		Colocate [][]string
-		Rollout  time.Duration
-	}
+// appConfig holds the data from under appKey in the TOML config.
+// It matches the contents of the Config proto.
+type appConfig struct {
+	Name          string
+	Binary        string
+	Args          []string
+	Env           []string
+	Colocate      [][]string
+	Rollout       time.Duration
+	LogLimits     []logLimit        `toml:"log_limits"`
+	LogLevels     map[string]string `toml:"log_levels"`
+	TraceSampling *traceSampling    `toml:"trace_sampling"`
+	TailSampling  *tailSampling     `toml:"tail_sampling"`
+}
 
+// logLimit holds a [[serviceweaver.log_limits]] entry in the TOML config. It
+// matches the contents of the LogLimit proto.
+type logLimit struct {
+	Component string
+	Level     string
+	Sample    float64
+	Rate      float64
+	Burst     int64
+}
+
+// traceSampling holds the [serviceweaver.trace_sampling] section of the TOML
+// config. It matches the contents of the TraceSampling proto.
+type traceSampling struct {
+	Ratio              *float64           // defaults to 1
+	ListenerRates      map[string]float64 `toml:"listener_rates"`
+	AlwaysSampleErrors bool               `toml:"always_sample_errors"`
+	MaxSpansPerSecond  float64            `toml:"max_spans_per_second"`
+}
+
+// tailSampling holds the [serviceweaver.tail_sampling] section of the TOML
+// config. It matches the contents of the TailSampling proto.
+type tailSampling struct {
+	Window     time.Duration
+	Errors     bool
+	Latency    time.Duration
+	Components []string
+	Ratio      float64
+}
+
+func extractApp(file string, config *protos.AppConfig) error {
 	parsed := &appConfig{}
 	if err := ParseConfigSection(appKey, shortAppKey, config.Sections, parsed); err != nil {
 		return err
@@ -126,6 +164,10 @@ func extractApp(file string, config *protos.AppConfig) error {
 		group := &protos.ComponentGroup{Components: colocate}
 		config.Colocate = append(config.Colocate, group)
 	}
+	config.LogLimits = parsed.logLimits()
+	config.LogLevels = parsed.LogLevels
+	config.TraceSampling = parsed.traceSampling()
+	config.TailSampling = parsed.tailSampling()
 
 	// Canonicalize the config.
 	if err := canonicalizeConfig(config, filepath.Dir(file)); err != nil {
@@ -134,6 +176,100 @@ func extractApp(file string, config *protos.AppConfig) error {
 	return nil
 }
 
+// logLimits returns the log limits in the parsed config.
+func (c *appConfig) logLimits() []*protos.LogLimit {
+	var limits []*protos.LogLimit
+	for _, l := range c.LogLimits {
+		limits = append(limits, &protos.LogLimit{
+			Component: l.Component,
+			Level:     l.Level,
+			Sample:    l.Sample,
+			Rate:      l.Rate,
+			Burst:     l.Burst,
+		})
+	}
+	return limits
+}
+
+// traceSampling returns the trace sampling policy in the parsed config, or nil
+// if there is none.
+func (c *appConfig) traceSampling() *protos.TraceSampling {
+	if c.TraceSampling == nil {
+		return nil
+	}
+	ratio := 1.0
+	if c.TraceSampling.Ratio != nil {
+		ratio = *c.TraceSampling.Ratio
+	}
+	return &protos.TraceSampling{
+		Ratio:              ratio,
+		ListenerRates:      c.TraceSampling.ListenerRates,
+		AlwaysSampleErrors: c.TraceSampling.AlwaysSampleErrors,
+		MaxSpansPerSecond:  c.TraceSampling.MaxSpansPerSecond,
+	}
+}
+
+// tailSampling returns the tail sampling policy in the parsed config, or nil if
+// there is none.
+func (c *appConfig) tailSampling() *protos.TailSampling {
+	if c.TailSampling == nil {
+		return nil
+	}
+	return &protos.TailSampling{
+		WindowNanos:  int64(c.TailSampling.Window),
+		Errors:       c.TailSampling.Errors,
+		LatencyNanos: int64(c.TailSampling.Latency),
+		Components:   c.TailSampling.Components,
+		Ratio:        c.TailSampling.Ratio,
+	}
+}
+
+// ParseLogLimits returns the log limits specified in the [serviceweaver]
+// section of the provided config sections. It is useful for processes that
+// receive the config sections, but not the parsed AppConfig.
+func ParseLogLimits(sections map[string]string) ([]*protos.LogLimit, error) {
+	parsed := &appConfig{}
+	if err := ParseConfigSection(appKey, shortAppKey, sections, parsed); err != nil {
+		return nil, err
+	}
+	limits := parsed.logLimits()
+	if err := checkLogLimits(limits); err != nil {
+		return nil, err
+	}
+	return limits, nil
+}
+
+// ParseLogLevels returns the base log levels specified in the [serviceweaver]
+// section of the provided config sections, keyed by component name. Like
+// ParseLogLimits, it is useful for processes that receive the config sections,
+// but not the parsed AppConfig.
+func ParseLogLevels(sections map[string]string) (map[string]string, error) {
+	parsed := &appConfig{}
+	if err := ParseConfigSection(appKey, shortAppKey, sections, parsed); err != nil {
+		return nil, err
+	}
+	if err := checkLogLevels(parsed.LogLevels); err != nil {
+		return nil, err
+	}
+	return parsed.LogLevels, nil
+}
+
+// ParseTraceSampling returns the trace sampling policy specified in the
+// [serviceweaver] section of the provided config sections, or nil if there is
+// none. Like ParseLogLimits, it is useful for processes that receive the
+// config sections, but not the parsed AppConfig.
+func ParseTraceSampling(sections map[string]string) (*protos.TraceSampling, error) {
+	parsed := &appConfig{}
+	if err := ParseConfigSection(appKey, shortAppKey, sections, parsed); err != nil {
+		return nil, err
+	}
+	sampling := parsed.traceSampling()
+	if err := checkTraceSampling(sampling); err != nil {
+		return nil, err
+	}
+	return sampling, nil
+}
+
 // canonicalizeConfig updates the provided config to canonical
 // form. All relative paths inside the configuration are resolved
 // relative to the provided directory.
@@ -163,6 +299,26 @@ func canonicalizeConfig(c *protos.AppConfig, dir string) error {
 	if err := checkSameProcess(c); err != nil {
 		return err
 	}
+
+	// Validate the log limits.
+	if err := checkLogLimits(c.LogLimits); err != nil {
+		return err
+	}
+
+	// Validate the log levels.
+	if err := checkLogLevels(c.LogLevels); err != nil {
+		return err
+	}
+
+	// Validate the trace sampling policy.
+	if err := checkTraceSampling(c.TraceSampling); err != nil {
+		return err
+	}
+
+	// Validate the tail sampling policy.
+	if err := checkTailSampling(c.TailSampling); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -179,3 +335,110 @@ func checkSameProcess(c *protos.AppConfig) error {
 	}
 	return nil
 }
+
+// checkLogLimits checks that the log_limits entries are valid.
+func checkLogLimits(limits []*protos.LogLimit) error {
+	type key struct{ component, level string }
+	seen := map[key]struct{}{}
+	for _, l := range limits {
+		if l.Level != "" {
+			var level slog.Level
+			if err := level.UnmarshalText([]byte(l.Level)); err != nil {
+				return fmt.Errorf("log limit: invalid level %q", l.Level)
+			}
+			l.Level = level.String()
+		}
+		k := key{l.Component, l.Level}
+		if _, ok := seen[k]; ok {
+			return fmt.Errorf("log limit: multiple limits for component %q and level %q", l.Component, l.Level)
+		}
+		seen[k] = struct{}{}
+		if l.Sample < 0 || l.Sample > 1 {
+			return fmt.Errorf("log limit: sample %v not in the range (0, 1]", l.Sample)
+		}
+		if l.Rate < 0 {
+			return fmt.Errorf("log limit: negative rate %v", l.Rate)
+		}
+		if l.Burst < 0 {
+			return fmt.Errorf("log limit: negative burst %d", l.Burst)
+		}
+		if l.Sample == 0 && l.Rate == 0 {
+			return fmt.Errorf("log limit for component %q and level %q has neither a sample nor a rate", l.Component, l.Level)
+		}
+	}
+	return nil
+}
+
+// checkLogLevels checks that the log_levels entries are valid, and puts the
+// levels in canonical form (e.g., "DEBUG").
+func checkLogLevels(levels map[string]string) error {
+	for component, l := range levels {
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(l)); err != nil {
+			return fmt.Errorf("log level: invalid level %q for component %q", l, component)
+		}
+		levels[component] = level.String()
+	}
+	return nil
+}
+
+// CheckComponentNames checks that every component named in the log_limits and
+// log_levels entries of the provided config is one of the provided components.
+// ParseConfig cannot perform this check, as it does not know the components
+// in the application binary.
+func CheckComponentNames(app *protos.AppConfig, components []string) error {
+	known := map[string]bool{}
+	for _, c := range components {
+		known[c] = true
+	}
+	for _, l := range app.LogLimits {
+		if l.Component != "" && !known[l.Component] {
+			return fmt.Errorf("log limit: component %q not found in the binary", l.Component)
+		}
+	}
+	for c := range app.LogLevels {
+		if !known[c] {
+			return fmt.Errorf("log level: component %q not found in the binary", c)
+		}
+	}
+	return nil
+}
+
+// checkTraceSampling checks that the trace_sampling entry is valid.
+func checkTraceSampling(sampling *protos.TraceSampling) error {
+	if sampling == nil {
+		return nil
+	}
+	if sampling.Ratio < 0 || sampling.Ratio > 1 {
+		return fmt.Errorf("trace sampling: ratio %v not in the range [0, 1]", sampling.Ratio)
+	}
+	for listener, rate := range sampling.ListenerRates {
+		if rate < 0 {
+			return fmt.Errorf("trace sampling: negative rate %v for listener %q", rate, listener)
+		}
+	}
+	if sampling.MaxSpansPerSecond < 0 {
+		return fmt.Errorf("trace sampling: negative max_spans_per_second %v", sampling.MaxSpansPerSecond)
+	}
+	return nil
+}
+
+// checkTailSampling checks that the tail_sampling entry is valid.
+func checkTailSampling(sampling *protos.TailSampling) error {
+	if sampling == nil {
+		return nil
+	}
+	if sampling.WindowNanos < 0 {
+		return fmt.Errorf("tail sampling: negative window %v", time.Duration(sampling.WindowNanos))
+	}
+	if sampling.LatencyNanos < 0 {
+		return fmt.Errorf("tail sampling: negative latency %v", time.Duration(sampling.LatencyNanos))
+	}
+	if sampling.Ratio < 0 || sampling.Ratio > 1 {
+		return fmt.Errorf("tail sampling: ratio %v not in the range [0, 1]", sampling.Ratio)
+	}
+	if !sampling.Errors && sampling.LatencyNanos == 0 && len(sampling.Components) == 0 && sampling.Ratio == 0 {
+		return fmt.Errorf("tail sampling: no policy keeps any traces")
+	}
+	return nil
+}
@@ -19,10 +19,13 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ServiceWeaver/weaver/runtime"
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
 func TestBinaryPath(t *testing.T) {
@@ -102,6 +105,187 @@ func TestParseConfigSection(t *testing.T) {
 	}
 }
 
+func TestLogLimits(t *testing.T) {
+	const config = `
+[serviceweaver]
+name = "app"
+
+[[serviceweaver.log_limits]]
+level = "debug"
+sample = 0.1
+
+[[serviceweaver.log_limits]]
+component = "github.com/my/project/Chatty"
+rate = 100
+burst = 200
+`
+	want := []*protos.LogLimit{
+		{Level: "DEBUG", Sample: 0.1},
+		{Component: "github.com/my/project/Chatty", Rate: 100, Burst: 200},
+	}
+
+	app, err := runtime.ParseConfig("weaver.toml", config, codegen.ComponentConfigValidator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, app.LogLimits, protocmp.Transform()); diff != "" {
+		t.Fatalf("ParseConfig: (-want +got):\n%s", diff)
+	}
+
+	limits, err := runtime.ParseLogLimits(app.Sections)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, limits, protocmp.Transform()); diff != "" {
+		t.Fatalf("ParseLogLimits: (-want +got):\n%s", diff)
+	}
+}
+
+func TestLogLevels(t *testing.T) {
+	const config = `
+[serviceweaver]
+name = "app"
+
+[serviceweaver.log_levels]
+"github.com/my/project/Chatty" = "warn"
+"github.com/my/project/Quiet" = "debug"
+`
+	want := map[string]string{
+		"github.com/my/project/Chatty": "WARN",
+		"github.com/my/project/Quiet":  "DEBUG",
+	}
+
+	app, err := runtime.ParseConfig("weaver.toml", config, codegen.ComponentConfigValidator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, app.LogLevels); diff != "" {
+		t.Fatalf("ParseConfig: (-want +got):\n%s", diff)
+	}
+
+	levels, err := runtime.ParseLogLevels(app.Sections)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, levels); diff != "" {
+		t.Fatalf("ParseLogLevels: (-want +got):\n%s", diff)
+	}
+}
+
+func TestCheckComponentNames(t *testing.T) {
+	components := []string{"github.com/my/project/Chatty"}
+	for _, test := range []struct {
+		name string
+		app  *protos.AppConfig
+		want string // expected error, or "" if no error
+	}{
+		{
+			name: "known components",
+			app: &protos.AppConfig{
+				LogLimits: []*protos.LogLimit{{Level: "DEBUG", Sample: 0.1}, {Component: "github.com/my/project/Chatty", Rate: 10}},
+				LogLevels: map[string]string{"github.com/my/project/Chatty": "WARN"},
+			},
+		},
+		{
+			name: "unknown log limit component",
+			app: &protos.AppConfig{
+				LogLimits: []*protos.LogLimit{{Component: "github.com/my/project/Chaty", Rate: 10}},
+			},
+			want: "log limit: component \"github.com/my/project/Chaty\" not found",
+		},
+		{
+			name: "unknown log level component",
+			app: &protos.AppConfig{
+				LogLevels: map[string]string{"Chatty": "WARN"},
+			},
+			want: "log level: component \"Chatty\" not found",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := runtime.CheckComponentNames(test.app, components)
+			if test.want == "" {
+				if err != nil {
+					t.Fatalf("CheckComponentNames: unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("CheckComponentNames: got error %v, want error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestTraceSampling(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		config string
+		want   *protos.TraceSampling
+	}{
+		{"missing", "[serviceweaver]\n", nil},
+		{"default ratio", "[serviceweaver.trace_sampling]\n", &protos.TraceSampling{Ratio: 1}},
+		{
+			"full",
+			`
+[serviceweaver.trace_sampling]
+ratio = 0.25
+always_sample_errors = true
+max_spans_per_second = 1000
+listener_rates = { api = 10, admin = 0.5 }
+`,
+			&protos.TraceSampling{
+				Ratio:              0.25,
+				ListenerRates:      map[string]float64{"api": 10, "admin": 0.5},
+				AlwaysSampleErrors: true,
+				MaxSpansPerSecond:  1000,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			app, err := runtime.ParseConfig("weaver.toml", test.config, codegen.ComponentConfigValidator)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, app.TraceSampling, protocmp.Transform()); diff != "" {
+				t.Fatalf("ParseConfig: (-want +got):\n%s", diff)
+			}
+
+			sampling, err := runtime.ParseTraceSampling(app.Sections)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, sampling, protocmp.Transform()); diff != "" {
+				t.Fatalf("ParseTraceSampling: (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTailSampling(t *testing.T) {
+	const config = `
+[serviceweaver.tail_sampling]
+window = "30s"
+errors = true
+latency = "500ms"
+components = ["github.com/shop/cart/Cart"]
+ratio = 0.01
+`
+	app, err := runtime.ParseConfig("weaver.toml", config, codegen.ComponentConfigValidator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &protos.TailSampling{
+		WindowNanos:  int64(30 * time.Second),
+		Errors:       true,
+		LatencyNanos: int64(500 * time.Millisecond),
+		Components:   []string{"github.com/shop/cart/Cart"},
+		Ratio:        0.01,
+	}
+	if diff := cmp.Diff(want, app.TailSampling, protocmp.Transform()); diff != "" {
+		t.Fatalf("ParseConfig: (-want +got):\n%s", diff)
+	}
+}
+
 func TestConfigErrors(t *testing.T) {
 	type testCase struct {
 		name          string
@@ -152,6 +336,100 @@ rollout = "hello"
 `,
 			expectedError: "invalid duration",
 		},
+		{
+			name: "bad log limit level",
+			cfg: `
+[[serviceweaver.log_limits]]
+level = "loud"
+rate = 10
+`,
+			expectedError: "invalid level",
+		},
+		{
+			name: "bad log limit sample",
+			cfg: `
+[[serviceweaver.log_limits]]
+sample = 2.0
+`,
+			expectedError: "not in the range",
+		},
+		{
+			name: "empty log limit",
+			cfg: `
+[[serviceweaver.log_limits]]
+component = "a"
+`,
+			expectedError: "neither a sample nor a rate",
+		},
+		{
+			name: "duplicate log limits",
+			cfg: `
+[[serviceweaver.log_limits]]
+level = "debug"
+rate = 10
+
+[[serviceweaver.log_limits]]
+level = "DEBUG"
+sample = 0.5
+`,
+			expectedError: "multiple limits",
+		},
+		{
+			name: "bad log level",
+			cfg: `
+[serviceweaver.log_levels]
+"a" = "loud"
+`,
+			expectedError: "invalid level",
+		},
+		{
+			name: "bad trace sampling ratio",
+			cfg: `
+[serviceweaver.trace_sampling]
+ratio = 1.5
+`,
+			expectedError: "not in the range",
+		},
+		{
+			name: "bad trace sampling listener rate",
+			cfg: `
+[serviceweaver.trace_sampling]
+listener_rates = { api = -1 }
+`,
+			expectedError: "negative rate",
+		},
+		{
+			name: "unknown trace sampling key",
+			cfg: `
+[serviceweaver.trace_sampling]
+rate = 10
+`,
+			expectedError: "unknown",
+		},
+		{
+			name: "bad tail sampling ratio",
+			cfg: `
+[serviceweaver.tail_sampling]
+ratio = -0.5
+`,
+			expectedError: "not in the range",
+		},
+		{
+			name: "negative tail sampling latency",
+			cfg: `
+[serviceweaver.tail_sampling]
+latency = "-1s"
+`,
+			expectedError: "negative latency",
+		},
+		{
+			name: "empty tail sampling policy",
+			cfg: `
+[serviceweaver.tail_sampling]
+window = "5s"
+`,
+			expectedError: "no policy",
+		},
 	} {
 		t.Run(c.name, func(t *testing.T) {
 			_, err := runtime.ParseConfig("weaver.toml", c.cfg, codegen.ComponentConfigValidator)
@@ -285,6 +285,12 @@ func (e *Envelope) GetProfile(req *protos.GetProfileRequest) ([]byte, error) {
 	return e.conn.GetProfileRPC(req)
 }
 
+// CallComponent calls a component method on the weavelet. See
+// protos.CallComponentRequest.
+func (e *Envelope) CallComponent(req *protos.CallComponentRequest) (*protos.CallComponentReply, error) {
+	return e.conn.CallComponentRPC(req)
+}
+
 // GetMetrics returns a weavelet's metrics.
 func (e *Envelope) GetMetrics() ([]*metrics.MetricSnapshot, error) {
 	return e.conn.GetMetricsRPC()
@@ -307,6 +313,18 @@ func (e *Envelope) UpdateRoutingInfo(routing *protos.RoutingInfo) error {
 	return e.conn.UpdateRoutingInfoRPC(routing)
 }
 
+// UpdateLeadership updates whether the weavelet's replica of the provided
+// singleton component is the component's leader.
+func (e *Envelope) UpdateLeadership(component string, leader bool) error {
+	return e.conn.UpdateLeadershipRPC(component, leader)
+}
+
+// SetLogLevel changes the minimum log level of a component hosted by the
+// weavelet. See protos.SetLogLevelRequest.
+func (e *Envelope) SetLogLevel(req *protos.SetLogLevelRequest) error {
+	return e.conn.SetLogLevelRPC(req)
+}
+
 func (e *Envelope) logLines(component string, src io.Reader, h EnvelopeHandler) error {
 	// Fill partial log entry.
 	entry := &protos.LogEntry{
@@ -16,6 +16,7 @@ package logging
 
 import (
 	"bufio"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
@@ -23,6 +24,9 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -39,24 +43,99 @@ import (
 // This file contains code to read and write log entries to and from files.
 
 // FileStore stores log entries in files.
+//
+// A FileStore writes the log entries of every (app, deployment, weavelet,
+// level) tuple to its own log file. If rotation is enabled in the
+// FileStoreOptions, a log file that grows too big or too old is renamed to a
+// numbered segment and a fresh log file is started in its place. Rotated
+// segments are optionally compressed with gzip and deleted once they exceed
+// the configured retention budget. FileSource transparently reads log files
+// along with their rotated segments.
 type FileStore struct {
-	dir string
-	mu  sync.Mutex
-	pp  *PrettyPrinter
+	dir  string
+	opts FileStoreOptions
+	mu   sync.Mutex
+	pp   *PrettyPrinter
 
 	// We segregate into log files by app,deployment,node,level.
-	files map[string]*os.File
+	files map[string]*logWriter
+
+	// maintaining guards the compression and deletion of rotated segments,
+	// which happen in background goroutines tracked by maintenance.
+	maintaining sync.Mutex
+	maintenance sync.WaitGroup
+}
+
+// FileStoreOptions configure the rotation and retention of the log files
+// written by a FileStore. The zero value disables rotation and retention, so
+// log files grow forever.
+type FileStoreOptions struct {
+	// A log file is rotated once it exceeds MaxFileSize bytes, or once it is
+	// older than MaxFileAge. A zero value disables the corresponding kind of
+	// rotation. Rotation happens when an entry is added, so a log file may
+	// exceed these limits until the next entry is written.
+	MaxFileSize int64
+	MaxFileAge  time.Duration
+
+	// If Compress is true, rotated segments are compressed with gzip.
+	Compress bool
+
+	// Rotated segments are deleted once they are older than MaxAge, and the
+	// oldest rotated segments are deleted while the total size of the rotated
+	// segments in the directory exceeds MaxTotalSize bytes. A zero value
+	// disables the corresponding kind of deletion.
+	//
+	// The limits apply to every rotated segment in the directory, including
+	// the segments written by other FileStores, so that the logs of
+	// deployments that are no longer running are eventually deleted. Log
+	// files that haven't been rotated, which may still be written to by other
+	// FileStores, are never deleted.
+	MaxAge       time.Duration
+	MaxTotalSize int64
+}
+
+// logWriter is a log file being written by a FileStore.
+type logWriter struct {
+	file    *os.File     // the log file
+	index   *indexWriter // the index of file, or nil if not indexed
+	size    int64        // number of bytes written to file
+	created time.Time    // when the log file was created
+}
+
+// close closes the log file and its index. The last block of the log file is
+// indexed before the index is closed.
+func (w *logWriter) close() error {
+	var errs []error
+	if w.index != nil {
+		errs = append(errs, w.index.flush(), w.index.file.Close())
+	}
+	errs = append(errs, w.file.Close())
+	return errors.Join(errs...)
+}
+
+// Write implements the io.Writer interface.
+func (w *logWriter) Write(p []byte) (int, error) {
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
 }
 
 // NewFileStore returns a LogStore that writes files to the specified directory.
 func NewFileStore(dir string) (*FileStore, error) {
+	return NewFileStoreWithOptions(dir, FileStoreOptions{})
+}
+
+// NewFileStoreWithOptions returns a LogStore that writes files to the
+// specified directory, rotating and retaining them as specified by opts.
+func NewFileStoreWithOptions(dir string, opts FileStoreOptions) (*FileStore, error) {
 	if err := os.MkdirAll(dir, 0750); err != nil {
 		return nil, err
 	}
 	return &FileStore{
 		dir:   dir,
+		opts:  opts,
 		pp:    NewPrettyPrinter(colors.Enabled()),
-		files: map[string]*os.File{},
+		files: map[string]*logWriter{},
 	}, nil
 }
 
@@ -65,14 +144,15 @@ func (fs *FileStore) Close() error {
 	fs.mu.Lock()
 	defer fs.mu.Unlock()
 	var err error
-	for name, f := range fs.files {
+	for name, w := range fs.files {
 		delete(fs.files, name)
-		if f != nil {
-			if fileErr := f.Close(); fileErr != nil && err == nil {
+		if w != nil {
+			if fileErr := w.close(); fileErr != nil && err == nil {
 				err = fileErr
 			}
 		}
 	}
+	fs.maintenance.Wait()
 	return err
 }
 
@@ -88,24 +168,43 @@ func (fs *FileStore) Add(e *protos.LogEntry) {
 		e.TimeMicros = time.Now().UnixMicro()
 	}
 
-	// Get the log file, creating it if necessary.
+	// Rotate the log file, if necessary.
 	fname := filename(e.App, e.Version, e.Node, e.Level)
-	f, ok := fs.files[fname]
+	w, ok := fs.files[fname]
+	if ok && w != nil && fs.shouldRotate(w) {
+		delete(fs.files, fname)
+		ok = false
+		if err := fs.rotate(fname, w); err != nil {
+			// Keep logging to a new log file, even though the old one
+			// couldn't be rotated.
+			fmt.Fprintf(os.Stderr, "rotate log file: %v\n", err)
+		}
+	}
+
+	// Get the log file, creating it if necessary.
 	if !ok {
-		var err error
-		f, err = os.Create(filepath.Join(fs.dir, fname))
+		f, err := os.Create(filepath.Join(fs.dir, fname))
 		if err != nil {
 			// Since we can't open the log file, fall back to stderr.
 			fmt.Fprintf(os.Stderr, "create log file: %v\n", err)
-			f = nil
+			w = nil
+		} else {
+			w = &logWriter{file: f, created: time.Now()}
+			if idx, err := os.Create(filepath.Join(fs.dir, indexFilename(fname))); err != nil {
+				// Log entries can still be read without an index.
+				fmt.Fprintf(os.Stderr, "create log index: %v\n", err)
+			} else {
+				w.index = newIndexWriter(idx)
+			}
 		}
-		fs.files[fname] = f
+		fs.files[fname] = w
 	}
 
 	// Write to log file if available.
-	if f != nil {
-		err := protomsg.Write(f, e)
+	if w != nil {
+		err := protomsg.Write(w, e)
 		if err == nil {
+			fs.indexEntry(fname, w, e)
 			return
 		}
 		// Fall back to stderr.
@@ -117,6 +216,199 @@ func (fs *FileStore) Add(e *protos.LogEntry) {
 	fmt.Fprintln(os.Stderr, fs.pp.Format(e))
 }
 
+// indexEntry adds an entry, just written to the provided log file, to the log
+// file's index. If the index can't be written, it is deleted.
+//
+// REQUIRES: fs.mu is held.
+func (fs *FileStore) indexEntry(fname string, w *logWriter, e *protos.LogEntry) {
+	if w.index == nil {
+		return
+	}
+	if err := w.index.add(e, w.size); err != nil {
+		// A partial index would hide the entries it doesn't cover, so we
+		// stop indexing the log file altogether.
+		fmt.Fprintf(os.Stderr, "write log index: %v\n", err)
+		w.index.file.Close()
+		os.Remove(filepath.Join(fs.dir, indexFilename(fname)))
+		w.index = nil
+	}
+}
+
+// shouldRotate returns whether the provided log file should be rotated.
+func (fs *FileStore) shouldRotate(w *logWriter) bool {
+	if fs.opts.MaxFileSize > 0 && w.size >= fs.opts.MaxFileSize {
+		return true
+	}
+	if fs.opts.MaxFileAge > 0 && time.Since(w.created) >= fs.opts.MaxFileAge {
+		return true
+	}
+	return false
+}
+
+// rotate closes the provided log file and renames it to the next segment of
+// the log file. The segment is then compressed and the retention budget is
+// enforced in the background.
+//
+// REQUIRES: fs.mu is held.
+func (fs *FileStore) rotate(fname string, w *logWriter) error {
+	if err := w.close(); err != nil {
+		// Don't leave behind an index that may not cover the whole segment.
+		os.Remove(filepath.Join(fs.dir, indexFilename(fname)))
+		return err
+	}
+	segments, err := fs.segments(fname)
+	if err != nil {
+		return err
+	}
+	seq := 1
+	if n := len(segments); n > 0 {
+		seq = segments[n-1].seq + 1
+	}
+	rotated := segmentFilename(fname, seq, false)
+	if err := os.Rename(filepath.Join(fs.dir, fname), filepath.Join(fs.dir, rotated)); err != nil {
+		return err
+	}
+	if w.index != nil {
+		err := os.Rename(filepath.Join(fs.dir, indexFilename(fname)), filepath.Join(fs.dir, indexFilename(rotated)))
+		if err != nil {
+			return err
+		}
+	}
+
+	fs.maintenance.Add(1)
+	go func() {
+		defer fs.maintenance.Done()
+		fs.maintaining.Lock()
+		defer fs.maintaining.Unlock()
+		if fs.opts.Compress {
+			if err := compress(filepath.Join(fs.dir, rotated)); err != nil {
+				fmt.Fprintf(os.Stderr, "compress log file: %v\n", err)
+			}
+		}
+		if err := fs.retain(); err != nil {
+			fmt.Fprintf(os.Stderr, "delete log files: %v\n", err)
+		}
+	}()
+	return nil
+}
+
+// segments returns the rotated segments of the provided log file, sorted by
+// sequence number.
+func (fs *FileStore) segments(fname string) ([]segment, error) {
+	groups, err := lsGroups(fs.dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		if g.name == fname {
+			return g.segments, nil
+		}
+	}
+	return nil, nil
+}
+
+// retain deletes the rotated segments in the directory that exceed the
+// retention budget. Log files that haven't been rotated and files that are
+// still being written (e.g., segments being compressed) are skipped.
+//
+// REQUIRES: fs.maintaining is held.
+func (fs *FileStore) retain() error {
+	if fs.opts.MaxAge <= 0 && fs.opts.MaxTotalSize <= 0 {
+		return nil
+	}
+
+	// Gather every rotated segment in the directory, oldest first.
+	type rotated struct {
+		filename string
+		size     int64
+		modified time.Time
+	}
+	groups, err := lsGroups(fs.dir)
+	if err != nil {
+		return err
+	}
+	var all []rotated
+	for _, g := range groups {
+		for _, s := range g.segments {
+			info, err := os.Stat(filepath.Join(fs.dir, s.filename))
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return err
+			}
+			all = append(all, rotated{s.filename, info.Size(), info.ModTime()})
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if !all[i].modified.Equal(all[j].modified) {
+			return all[i].modified.Before(all[j].modified)
+		}
+		return all[i].filename < all[j].filename
+	})
+
+	var total int64
+	for _, r := range all {
+		total += r.size
+	}
+	var errs []error
+	for _, r := range all {
+		expired := fs.opts.MaxAge > 0 && time.Since(r.modified) > fs.opts.MaxAge
+		overBudget := fs.opts.MaxTotalSize > 0 && total > fs.opts.MaxTotalSize
+		if !expired && !overBudget {
+			continue
+		}
+		err := os.Remove(filepath.Join(fs.dir, r.filename))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+			continue
+		}
+		total -= r.size
+		err = os.Remove(filepath.Join(fs.dir, indexFilename(r.filename)))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// compress compresses the provided rotated segment with gzip, replacing the
+// segment with a compressed segment.
+func compress(filename string) error {
+	src, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	// Write the compressed segment to a temporary file and rename it once it
+	// is complete, so that readers never see a partially compressed segment.
+	tmp := filename + gzipSuffix + tmpSuffix
+	dst, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	if _, err := io.Copy(zw, src); err != nil {
+		dst.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		dst.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, filename+gzipSuffix); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Remove(filename)
+}
+
 // filename returns the log file for the specified (app, deployment, weavelet,
 // level) tuple.
 //
@@ -184,6 +476,186 @@ func parseLogfile(filename string) (logfile, error) {
 	}, nil
 }
 
+const (
+	gzipSuffix = ".gz"  // suffix of compressed segments
+	tmpSuffix  = ".tmp" // suffix of files that are still being written
+)
+
+// segmentFilename returns the filename of the rotated segment of the provided
+// log file with the provided sequence number. For example, the first segment
+// of "todo#v1#111#info.log" is "todo#v1#111#info#000001.log", or
+// "todo#v1#111#info#000001.log.gz" if it is compressed.
+func segmentFilename(fname string, seq int, compressed bool) string {
+	segment := fmt.Sprintf("%s#%06d.log", strings.TrimSuffix(fname, ".log"), seq)
+	if compressed {
+		segment += gzipSuffix
+	}
+	return segment
+}
+
+// segment is a log file or one of its rotated segments.
+type segment struct {
+	filename   string // filename of the segment
+	seq        int    // sequence number, or 0 for the log file itself
+	compressed bool   // is the segment compressed with gzip?
+}
+
+// parseSegment parses the filename of a log file or of one of its rotated
+// segments. It returns the filename of the log file and the segment.
+func parseSegment(filename string) (string, segment, error) {
+	name, compressed := strings.CutSuffix(filename, gzipSuffix)
+	if prefix, ok := strings.CutSuffix(name, ".log"); ok && strings.Count(prefix, "#") == 4 {
+		i := strings.LastIndex(prefix, "#")
+		if seq, err := strconv.Atoi(prefix[i+1:]); err == nil && seq > 0 {
+			fname := prefix[:i] + ".log"
+			return fname, segment{filename: filename, seq: seq, compressed: compressed}, nil
+		}
+	}
+	if compressed {
+		return "", segment{}, fmt.Errorf("compressed file %q is not a rotated log file", filename)
+	}
+	if _, err := parseLogfile(filename); err != nil {
+		return "", segment{}, err
+	}
+	return filename, segment{filename: filename}, nil
+}
+
+// logGroup is a log file along with its rotated segments.
+type logGroup struct {
+	name     string    // filename of the log file
+	logfile  logfile   // parsed filename of the log file
+	active   bool      // does the log file itself exist?
+	segments []segment // rotated segments, sorted by sequence number
+}
+
+// filenames returns the filenames of the group's rotated segments followed by
+// the log file itself, if it exists. Entries in earlier files are older.
+func (g *logGroup) filenames() []string {
+	var filenames []string
+	for _, s := range g.segments {
+		filenames = append(filenames, s.filename)
+	}
+	if g.active {
+		filenames = append(filenames, g.name)
+	}
+	return filenames
+}
+
+// lsGroups returns the log files in dir along with their rotated segments,
+// sorted by filename.
+func lsGroups(dir string) ([]logGroup, error) {
+	direntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string]*logGroup{}
+	for _, direntry := range direntries {
+		if direntry.IsDir() {
+			return nil, fmt.Errorf("unexpected directory %q in %q", direntry.Name(), dir)
+		}
+		if strings.HasSuffix(direntry.Name(), tmpSuffix) {
+			// The file is still being written.
+			continue
+		}
+		if strings.HasSuffix(direntry.Name(), idxSuffix) {
+			// Indices are read along with their log files.
+			continue
+		}
+		fname, seg, err := parseSegment(direntry.Name())
+		if err != nil {
+			return nil, err
+		}
+		g, ok := groups[fname]
+		if !ok {
+			logfile, err := parseLogfile(fname)
+			if err != nil {
+				return nil, err
+			}
+			g = &logGroup{name: fname, logfile: logfile}
+			groups[fname] = g
+		}
+		if seg.seq == 0 {
+			g.active = true
+			continue
+		}
+		g.segments = append(g.segments, seg)
+	}
+
+	sorted := make([]logGroup, 0, len(groups))
+	for _, g := range groups {
+		sort.Slice(g.segments, func(i, j int) bool {
+			if g.segments[i].seq != g.segments[j].seq {
+				return g.segments[i].seq < g.segments[j].seq
+			}
+			// Compressed segments come first.
+			return g.segments[i].compressed
+		})
+		// A segment briefly exists both compressed and uncompressed while
+		// it is being compressed. Keep only the compressed one, as the
+		// uncompressed one is about to be deleted.
+		g.segments = slices.CompactFunc(g.segments, func(a, b segment) bool {
+			return a.seq == b.seq
+		})
+		sorted = append(sorted, *g)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].name < sorted[j].name })
+	return sorted, nil
+}
+
+// errSkipped is returned by openSegment when none of the entries in a rotated
+// segment can match a query.
+var errSkipped = errors.New("segment skipped")
+
+// openSegment opens the provided file in dir, decompressing it if necessary.
+// If an uncompressed segment was compressed and deleted since it was listed,
+// the compressed segment is opened instead. The returned closer closes the
+// underlying file.
+//
+// The returned reader uses the file's index, if any, to skip the blocks of
+// the file that don't pass the provided filter. If no block of a rotated
+// segment passes the filter, the segment isn't opened and errSkipped is
+// returned.
+func openSegment(dir, filename string, filter blockFilter) (io.Reader, io.Closer, error) {
+	// Read the index before opening the file. If the index is replaced
+	// after we open the file, the log file was rotated before we opened it,
+	// and the index doesn't describe the file we opened.
+	records, idx, err := readIndex(filepath.Join(dir, filename))
+	if err != nil {
+		return nil, nil, err
+	}
+	_, seg, perr := parseSegment(filename)
+	rotated := perr == nil && seg.seq > 0
+	if rotated && len(records) > 0 && !filter.passesAny(records) {
+		// Rotated segments are fully indexed.
+		return nil, nil, errSkipped
+	}
+
+	f, err := os.Open(filepath.Join(dir, filename))
+	if errors.Is(err, os.ErrNotExist) && rotated && !seg.compressed {
+		filename += gzipSuffix
+		f, err = os.Open(filepath.Join(dir, filename))
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if idx != nil {
+		info, err := os.Stat(filepath.Join(dir, indexFilename(filename)))
+		if err != nil || !os.SameFile(idx, info) {
+			records = nil
+		}
+	}
+	if !strings.HasSuffix(filename, gzipSuffix) {
+		return newFilteredReader(f, records, filter), f, nil
+	}
+	zr, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("open %q: %w", filename, err)
+	}
+	return newFilteredReader(zr, records, filter), f, nil
+}
+
 // matches returns whether the provided compiled query may match some log
 // entries in this logfile.
 func (l *logfile) matches(prog cel.Program) (bool, error) {
@@ -297,7 +769,7 @@ func (fq *fileSource) Query(_ context.Context, q Query, follow bool) (Reader, er
 type fileCatter struct {
 	prog   cel.Program           // query for filtering log entries
 	h      *heap.Heap[*buffered] // heap of *buffered
-	files  []*os.File            // underlying files being read
+	files  []io.Closer           // underlying files being read
 	closed bool                  // true if Close() has been called
 }
 
@@ -311,25 +783,36 @@ func newFileCatter(logdir string, q Query) (*fileCatter, error) {
 	if err != nil {
 		return nil, err
 	}
+	filter := newBlockFilter(ast.Expr())
 
 	// Construct the heap.
 	h := heap.New(func(a, b *buffered) bool {
 		return a.peek().TimeMicros < b.peek().TimeMicros
 	})
-	filenames, err := ls(logdir, prog)
+	groups, err := ls(logdir, prog)
 	if err != nil {
 		return nil, err
 	}
-	files := make([]*os.File, 0, len(filenames))
-	for _, filename := range filenames {
-		// TODO(mwhittaker): Close this file if we return an error.
-		file, err := os.Open(filepath.Join(logdir, filename))
-		if err != nil {
-			return nil, err
+	var files []io.Closer
+	for _, group := range groups {
+		// Read the rotated segments of the log file, oldest first, followed
+		// by the log file itself.
+		var readers []io.Reader
+		for _, filename := range group.filenames() {
+			// TODO(mwhittaker): Close this file if we return an error.
+			r, file, err := openSegment(logdir, filename, filter)
+			if errors.Is(err, os.ErrNotExist) || errors.Is(err, errSkipped) {
+				// The segment was deleted after we listed it, or none of
+				// its entries match the query.
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			files = append(files, file)
+			readers = append(readers, r)
 		}
-		files = append(files, file)
 
-		buffered := newBuffered(file.Name(), file)
+		buffered := newBuffered(filepath.Join(logdir, group.name), io.MultiReader(readers...))
 		if err = buffered.buffer(); err != nil {
 			return nil, err
 		}
@@ -443,7 +926,8 @@ func (fc *fileCatter) Close() {
 // fileFollower is a Reader implementation that reads from files written by a
 // FileLogger.
 type fileFollower struct {
-	prog cel.Program // the compiled user provided query
+	prog   cel.Program // the compiled user provided query
+	filter blockFilter // summary of the query used to skip indexed blocks
 
 	mu         sync.Mutex               // guards the following fields
 	scanners   map[string]*fileScanner  // all scanners, keyed by filename
@@ -460,14 +944,21 @@ type fileFollower struct {
 }
 
 type fileScanner struct {
-	file    *os.File              // file being scanned
-	entry   *protos.LogEntry      // buffered entry scanned from scanner
-	buf     chan *protos.LogEntry // buffer of entries scanned from scanner
-	blocked bool                  // is tailReader blocked?
-	reader  *tailReader           // reads the file
-	ready   *cond.Cond            // signals reader that more bytes are ready
+	segments []io.Closer           // rotated segments being scanned
+	file     *os.File              // file being scanned, or nil
+	next     []*os.File            // files that replaced file, oldest first
+	entry    *protos.LogEntry      // buffered entry scanned from scanner
+	buf      chan *protos.LogEntry // buffer of entries scanned from scanner
+	blocked  bool                  // is tailReader blocked?
+	reader   io.Reader             // reads the segments and then the file
+	ready    *cond.Cond            // signals reader that more bytes are ready
 }
 
+// errRotated is returned by a fileScanner's tailReader when the file being
+// scanned has been fully read and replaced by a newer file, because the
+// FileStore writing it rotated it.
+var errRotated = errors.New("log file rotated")
+
 func (fs *fileScanner) onHeap() bool {
 	return fs.entry != nil
 }
@@ -497,7 +988,8 @@ func newFileFollower(logdir string, q Query) (*fileFollower, error) {
 	// Construct the follower.
 	ctx, cancel := context.WithCancel(context.Background())
 	follower := fileFollower{
-		prog: prog,
+		prog:   prog,
+		filter: newBlockFilter(ast.Expr()),
 
 		scanners: map[string]*fileScanner{},
 		h: heap.New(func(a, b *fileScanner) bool {
@@ -516,13 +1008,12 @@ func newFileFollower(logdir string, q Query) (*fileFollower, error) {
 	// Add all existing files. If any of these files were created after the
 	// watcher started watching, then we'll also get a notification from the
 	// watcher, but that's okay.
-	filenames, err := ls(logdir, prog)
+	groups, err := ls(logdir, prog)
 	if err != nil {
 		return nil, err
 	}
-	for _, filename := range filenames {
-		abs := filepath.Join(logdir, filename)
-		if err := follower.created(abs); err != nil {
+	for _, group := range groups {
+		if err := follower.follow(logdir, group); err != nil {
 			return nil, err
 		}
 	}
@@ -591,7 +1082,15 @@ func (ff *fileFollower) Close() {
 	ff.cancel()
 	ff.done.Wait()
 	for _, scanner := range ff.scanners {
-		scanner.file.Close()
+		for _, segment := range scanner.segments {
+			segment.Close()
+		}
+		if scanner.file != nil {
+			scanner.file.Close()
+		}
+		for _, file := range scanner.next {
+			file.Close()
+		}
 	}
 }
 
@@ -622,18 +1121,39 @@ func (ff *fileFollower) spawn(f func() error) {
 
 // created updates a fileFollower with a file it may have never seen before.
 func (ff *fileFollower) created(filename string) error {
-	ff.mu.Lock()
-	defer ff.mu.Unlock()
-
-	// We've already seen this file before. This is only possible when our call
-	// to ls in newFileFollower races ff.watcher and they both report the same
-	// file. We make sure not to process the same file twice.
-	if _, seen := ff.scanners[filename]; seen {
+	filename = filepath.Clean(filename)
+	dir, base := filepath.Split(filename)
+	if strings.HasSuffix(base, tmpSuffix) {
+		// The file is still being written.
+		return nil
+	}
+	if strings.HasSuffix(base, idxSuffix) {
+		// Indices are read along with their log files.
 		return nil
 	}
+	fname, seg, err := parseSegment(base)
+	if err != nil {
+		return err
+	}
+	if seg.seq != 0 {
+		// A log file was rotated, or a rotated segment was compressed. We
+		// read the contents of rotated segments through their log file.
+		return nil
+	}
+
+	ff.mu.Lock()
+	fs, seen := ff.scanners[filename]
+	ff.mu.Unlock()
+	if seen {
+		// We've seen this log file before. Either the log file was rotated
+		// and a new log file was created in its place, or our call to ls in
+		// newFileFollower raced ff.watcher and they both reported the same
+		// file. We make sure not to process the same file twice.
+		return ff.replaced(fs, filename)
+	}
 
 	// Check to see if we need to watch this file.
-	logfile, err := parseLogfile(filepath.Base(filename))
+	logfile, err := parseLogfile(fname)
 	if err != nil {
 		return err
 	}
@@ -645,23 +1165,69 @@ func (ff *fileFollower) created(filename string) error {
 		return nil
 	}
 
-	// Open the file.
-	file, err := os.Open(filename)
+	// The log file may have been rotated since it was created, so follow its
+	// rotated segments as well.
+	groups, err := lsGroups(dir)
 	if err != nil {
 		return err
 	}
+	for _, group := range groups {
+		if group.name == fname {
+			return ff.follow(dir, group)
+		}
+	}
+	return ff.follow(dir, logGroup{name: fname, logfile: logfile, active: true})
+}
 
-	// Make a tailReader for the file.
+// follow starts following the provided log file, after reading its rotated
+// segments.
+func (ff *fileFollower) follow(dir string, group logGroup) error {
+	ff.mu.Lock()
+	defer ff.mu.Unlock()
+
+	filename := filepath.Join(dir, group.name)
+	if _, seen := ff.scanners[filename]; seen {
+		return nil
+	}
+
+	// Open the rotated segments.
 	fs := &fileScanner{
-		file:    file,
 		entry:   nil,
 		buf:     make(chan *protos.LogEntry, 10),
 		blocked: false,
 		reader:  nil,
 		ready:   cond.NewCond(&ff.mu),
 	}
-	reader := newTailReader(file, func() error { return ff.waitForChanges(fs) })
-	fs.reader = reader
+	var readers []io.Reader
+	for _, segment := range group.segments {
+		r, file, err := openSegment(dir, segment.filename, ff.filter)
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, errSkipped) {
+			// The segment was deleted after we listed it, or none of its
+			// entries match the query.
+			continue
+		} else if err != nil {
+			return err
+		}
+		fs.segments = append(fs.segments, file)
+		readers = append(readers, r)
+	}
+
+	// Open the file. If the file doesn't exist, we wait for it to be created.
+	var src io.Reader = strings.NewReader("")
+	if group.active {
+		file, err := os.Open(filename)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		if err == nil {
+			fs.file = file
+			src = file
+		}
+	}
+
+	// Make a tailReader for the file.
+	reader := newTailReader(src, func() error { return ff.waitForChanges(fs) })
+	fs.reader = io.MultiReader(append(readers, reader)...)
 	ff.scanners[filename] = fs
 
 	// Launch a goroutine that scans the file.
@@ -669,6 +1235,54 @@ func (ff *fileFollower) created(filename string) error {
 	return nil
 }
 
+// replaced updates the provided fileScanner with a newly created log file,
+// which replaces the file the fileScanner is reading.
+func (ff *fileFollower) replaced(fs *fileScanner, filename string) error {
+	file, err := os.Open(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		// The new log file was already rotated. We'll get a notification for
+		// its replacement.
+		return nil
+	} else if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	ff.mu.Lock()
+	defer ff.mu.Unlock()
+	latest := fs.file
+	if n := len(fs.next); n > 0 {
+		latest = fs.next[n-1]
+	}
+	if latest != nil {
+		if latestInfo, err := latest.Stat(); err == nil && os.SameFile(info, latestInfo) {
+			// We're already reading this file.
+			file.Close()
+			return nil
+		}
+	}
+	fs.next = append(fs.next, file)
+	fs.ready.Signal()
+	return nil
+}
+
+// rotate switches the provided fileScanner, which has fully read its file, to
+// the file that replaced it.
+func (ff *fileFollower) rotate(fs *fileScanner) {
+	ff.mu.Lock()
+	defer ff.mu.Unlock()
+	if fs.file != nil {
+		fs.file.Close()
+	}
+	fs.file = fs.next[0]
+	fs.next = fs.next[1:]
+	fs.reader = newTailReader(fs.file, func() error { return ff.waitForChanges(fs) })
+}
+
 // waitForChanges blocks on fs.ready, waiting for more bytes to be written to
 // fs.file. The watcher goroutine (see the watch method) will signal fs.ready
 // when it detects that the file has been written to.
@@ -676,6 +1290,11 @@ func (ff *fileFollower) waitForChanges(fs *fileScanner) error {
 	ff.mu.Lock()
 	defer ff.mu.Unlock()
 
+	if len(fs.next) > 0 {
+		// The file has been fully read, and there is a newer file to read.
+		return errRotated
+	}
+
 	fs.blocked = true
 	if !fs.onHeap() {
 		// The fileScanner is not on the heap, and now it's blocked, so
@@ -709,6 +1328,10 @@ func (ff *fileFollower) scan(fs *fileScanner) error {
 		// Note that fs.reader is cancelled when ff.ctx is cancelled. This will
 		// also cause scanner.Scan to be cancelled.
 		err := protomsg.Read(fs.reader, entry)
+		if errors.Is(err, errRotated) {
+			ff.rotate(fs)
+			continue
+		}
 		if err != nil {
 			return err
 		}
@@ -787,7 +1410,12 @@ func (ff *fileFollower) watch(ctx context.Context) error {
 
 		case event := <-ff.watcher.Events:
 			switch event.Op {
-			case fsnotify.Remove, fsnotify.Rename, fsnotify.Chmod:
+			case fsnotify.Remove, fsnotify.Rename:
+				// A FileStore renames a log file when it rotates it, and it
+				// renames and removes rotated segments when it compresses or
+				// deletes them. Files that are being read remain readable.
+
+			case fsnotify.Chmod:
 				return fmt.Errorf("unexpected operation %v", event.Op)
 
 			case fsnotify.Create:
@@ -828,32 +1456,24 @@ func (ff *fileFollower) watch(ctx context.Context) error {
 	}
 }
 
-// ls returns the set of filenames in dir that match the provided query.
-func ls(dir string, prog cel.Program) ([]string, error) {
-	direntries, err := os.ReadDir(dir)
+// ls returns the log files in dir, along with their rotated segments, that
+// match the provided query.
+func ls(dir string, prog cel.Program) ([]logGroup, error) {
+	groups, err := lsGroups(dir)
 	if err != nil {
 		return nil, err
 	}
-
-	filenames := make([]string, 0, len(direntries))
-	for _, direntry := range direntries {
-		if direntry.IsDir() {
-			return nil, fmt.Errorf("unexpected directory %q in %q", direntry.Name(), dir)
-		}
-		filename := direntry.Name()
-		logfile, err := parseLogfile(filename)
-		if err != nil {
-			return nil, err
-		}
-		matches, err := logfile.matches(prog)
+	matching := make([]logGroup, 0, len(groups))
+	for _, g := range groups {
+		matches, err := g.logfile.matches(prog)
 		if err != nil {
 			return nil, err
 		}
 		if matches {
-			filenames = append(filenames, filename)
+			matching = append(matching, g)
 		}
 	}
-	return filenames, nil
+	return matching, nil
 }
 
 // buffered is an entryScanner with a buffered *Entry scanned from it.
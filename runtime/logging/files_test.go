@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -424,3 +425,340 @@ func take(t *testing.T, ctx context.Context, r Reader, n int) []*protos.LogEntry
 	}
 	return entries
 }
+
+func TestParseSegment(t *testing.T) {
+	const fname = "a#b#c#d.log"
+	for _, want := range []segment{
+		{filename: fname},
+		{filename: segmentFilename(fname, 1, false), seq: 1},
+		{filename: segmentFilename(fname, 42, true), seq: 42, compressed: true},
+	} {
+		t.Run(want.filename, func(t *testing.T) {
+			gotName, got, err := parseSegment(want.filename)
+			if err != nil {
+				t.Fatalf("parseSegment: %v", err)
+			}
+			if gotName != fname {
+				t.Errorf("parseSegment: got log file %q, want %q", gotName, fname)
+			}
+			if got != want {
+				t.Errorf("parseSegment: got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+// storeEntries adds n entries to fs, with messages 0, ..., n-1, and returns
+// them.
+func storeEntries(fs *FileStore, n int) []*protos.LogEntry {
+	var entries []*protos.LogEntry
+	for i := 0; i < n; i++ {
+		e := &protos.LogEntry{
+			App:     "test",
+			Version: "v1",
+			Node:    "1",
+			Level:   "info",
+			Msg:     strconv.Itoa(i),
+		}
+		fs.Add(e)
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// segments returns the filenames of the rotated segments in logdir.
+func segments(t *testing.T) []string {
+	t.Helper()
+	groups, err := lsGroups(logdir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var filenames []string
+	for _, g := range groups {
+		for _, s := range g.segments {
+			filenames = append(filenames, s.filename)
+		}
+	}
+	return filenames
+}
+
+func TestFileStoreRotation(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		t.Run(fmt.Sprintf("Compress=%t", compress), func(t *testing.T) {
+			logdir = t.TempDir()
+			ctx := ctx(t)
+
+			// Store entries, rotating frequently.
+			fs, err := NewFileStoreWithOptions(logdir, FileStoreOptions{
+				MaxFileSize: 1000,
+				Compress:    compress,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := storeEntries(fs, 500)
+			if err := fs.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			// Check the segments.
+			rotated := segments(t)
+			if len(rotated) < 2 {
+				t.Fatalf("got %d rotated segments, want at least 2", len(rotated))
+			}
+			for _, filename := range rotated {
+				if got := strings.HasSuffix(filename, gzipSuffix); got != compress {
+					t.Errorf("segment %q compressed: got %t, want %t", filename, got, compress)
+				}
+			}
+
+			// Cat the entries, in order.
+			got := drain(t, ctx, cat(t, ctx, `app == "test"`))
+			if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("bad cat (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFileStoreRetention(t *testing.T) {
+	logdir = t.TempDir()
+	ctx := ctx(t)
+
+	// Store entries, retaining only a few rotated segments.
+	fs, err := NewFileStoreWithOptions(logdir, FileStoreOptions{
+		MaxFileSize:  1000,
+		MaxTotalSize: 3000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := storeEntries(fs, 500)
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Check that the oldest segments were deleted.
+	var total int64
+	for _, filename := range segments(t) {
+		info, err := os.Stat(filepath.Join(logdir, filename))
+		if err != nil {
+			t.Fatal(err)
+		}
+		total += info.Size()
+	}
+	if total > 3000 {
+		t.Errorf("rotated segments have %d bytes, want at most 3000", total)
+	}
+
+	// The remaining entries should be the most recent ones.
+	got := drain(t, ctx, cat(t, ctx, `app == "test"`))
+	if len(got) == 0 || len(got) == len(all) {
+		t.Fatalf("got %d entries, want between 1 and %d", len(got), len(all)-1)
+	}
+	want := all[len(all)-len(got):]
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("bad cat (-want +got):\n%s", diff)
+	}
+}
+
+func TestFileStoreRetentionOtherFiles(t *testing.T) {
+	logdir = t.TempDir()
+
+	// Store entries of another deployment, without retention. The
+	// deployment's last log file isn't rotated.
+	other, err := NewFileStoreWithOptions(logdir, FileStoreOptions{MaxFileSize: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		other.Add(&protos.LogEntry{App: "other", Version: "v1", Node: "1", Level: "info", Msg: strconv.Itoa(i)})
+	}
+	if err := other.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Store entries in the same directory, retaining only a few rotated
+	// segments.
+	fs, err := NewFileStoreWithOptions(logdir, FileStoreOptions{
+		MaxFileSize:  1000,
+		MaxTotalSize: 3000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeEntries(fs, 500)
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The other deployment's rotated segments are the oldest, so they should
+	// have been deleted, but its log file should be kept.
+	groups, err := lsGroups(logdir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, g := range groups {
+		if g.logfile.app != "other" {
+			continue
+		}
+		found = true
+		if len(g.segments) != 0 {
+			t.Errorf("%s: got %d rotated segments, want 0", g.name, len(g.segments))
+		}
+		if !g.active {
+			t.Errorf("%s: log file deleted", g.name)
+		}
+	}
+	if !found {
+		t.Fatal("log file of the other deployment not found")
+	}
+}
+
+func TestFileFollowerRotation(t *testing.T) {
+	logdir = t.TempDir()
+	ctx := ctx(t)
+
+	fs, err := NewFileStoreWithOptions(logdir, FileStoreOptions{
+		MaxFileSize: 1000,
+		Compress:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fs.Close()
+
+	// Store some entries before following and some after, rotating
+	// frequently.
+	want := storeEntries(fs, 100)
+	r := follow(t, ctx, `app == "test"`)
+	go func() {
+		for i := 100; i < 500; i++ {
+			fs.Add(&protos.LogEntry{
+				App:     "test",
+				Version: "v1",
+				Node:    "1",
+				Level:   "info",
+				Msg:     strconv.Itoa(i),
+			})
+			time.Sleep(100 * time.Microsecond)
+		}
+	}()
+	for i := 100; i < 500; i++ {
+		want = append(want, &protos.LogEntry{
+			App:     "test",
+			Version: "v1",
+			Node:    "1",
+			Level:   "info",
+			Msg:     strconv.Itoa(i),
+		})
+	}
+
+	// The entries should be followed in order, across rotations.
+	got := take(t, ctx, r, len(want))
+	ignoreTime := protocmp.IgnoreFields(&protos.LogEntry{}, "time_micros")
+	if diff := cmp.Diff(want, got, protocmp.Transform(), ignoreTime); diff != "" {
+		t.Errorf("bad follow (-want +got):\n%s", diff)
+	}
+}
+
+// fixtures stores a set of realistic log entries, logged over the last hour,
+// and returns them.
+func fixtures(t *testing.T, fs *FileStore) []*protos.LogEntry {
+	t.Helper()
+	now := time.Now()
+	entries := []*protos.LogEntry{
+		{App: "todo", Version: "v1", Node: "1", Level: "info", TimeMicros: now.Add(-time.Hour).UnixMicro(), Msg: "started", Attrs: []string{"port", "8000"}},
+		{App: "todo", Version: "v1", Node: "1", Level: "info", TimeMicros: now.Add(-50 * time.Minute).UnixMicro(), Msg: "handled request", Attrs: []string{"path", "/add", "code", "200", "latency", "12ms"}},
+		{App: "todo", Version: "v1", Node: "1", Level: "error", TimeMicros: now.Add(-40 * time.Minute).UnixMicro(), Msg: "timeout after 30s", Attrs: []string{"path", "/list", "code", "504", "latency", "30s"}},
+		{App: "todo", Version: "v1", Node: "2", Level: "info", TimeMicros: now.Add(-10 * time.Minute).UnixMicro(), Msg: "handled request", Attrs: []string{"path", "/list", "code", "200", "latency", "250ms"}},
+		{App: "todo", Version: "v1", Node: "2", Level: "error", TimeMicros: now.Add(-5 * time.Minute).UnixMicro(), Msg: "timeout after 5s", Attrs: []string{"path", "/add", "code", "503", "latency", "5s"}},
+		{App: "todo", Version: "v1", Node: "2", Level: "warn", TimeMicros: now.Add(-time.Minute).UnixMicro(), Msg: "slow request", Attrs: []string{"path", "/add", "code", "two hundred", "ratio", "0.75"}},
+		{App: "chat", Version: "v1", Node: "3", Level: "info", TimeMicros: now.Add(-2 * time.Minute).UnixMicro(), Msg: "timeout ignored", Attrs: []string{"code", "500", "ratio", "0.25"}},
+	}
+	for _, e := range entries {
+		fs.Add(e)
+	}
+	return entries
+}
+
+func TestRicherQueries(t *testing.T) {
+	logdir = t.TempDir()
+	ctx := ctx(t)
+	fs, err := NewFileStore(logdir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := fixtures(t, fs)
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		query Query
+		want  []int // indices into all
+	}{
+		{`msg.matches("timeout.*[0-9]+s$")`, []int{2, 4}},
+		{`msg.startsWith("timeout") && app == "todo"`, []int{2, 4}},
+		{`attrs["path"].endsWith("list")`, []int{2, 3}},
+		{`time > now - duration("15m")`, []int{3, 4, 6, 5}},
+		{`time > now - duration("15m") && time < now - duration("3m")`, []int{3, 4}},
+		{`app == "todo" && time <= now - duration("45m")`, []int{0, 1}},
+		{`int(attrs["code"]) >= 500`, []int{2, 4, 6}},
+		{`app == "todo" && !(int(attrs["code"]) >= 500)`, []int{0, 1, 3}},
+		{`double(attrs["ratio"]) > 0.5`, []int{5}},
+		{`duration(attrs["latency"]) > duration("100ms")`, []int{2, 3, 4}},
+		{`duration(attrs["latency"]) > duration("1s") && time > now - duration("15m")`, []int{4}},
+	} {
+		t.Run(test.query, func(t *testing.T) {
+			var want []*protos.LogEntry
+			for _, i := range test.want {
+				want = append(want, all[i])
+			}
+			got := drain(t, ctx, cat(t, ctx, test.query))
+			if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("bad cat (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLogfileMatchesRicherQueries(t *testing.T) {
+	todo := logfile{app: "todo", deployment: "v1", weavelet: "1", level: "info"}
+	for _, test := range []struct {
+		query Query
+		want  bool
+	}{
+		// Queries that can be pruned using only the file name.
+		{`app == "chat" && msg.matches("timeout.*")`, false},
+		{`app == "chat" && time > now - duration("15m")`, false},
+		{`app == "chat" && int(attrs["code"]) >= 500`, false},
+		{`level == "error" && duration(attrs["latency"]) > duration("1s")`, false},
+		{`app == "chat" || (level == "debug" && msg.startsWith("a"))`, false},
+
+		// Queries that may match some entries in the file.
+		{`app == "todo" && msg.matches("timeout.*")`, true},
+		{`time > now - duration("15m")`, true},
+		{`int(attrs["code"]) >= 500 || app == "chat"`, true},
+		{`level == "info" && attrs["path"].endsWith(".go")`, true},
+	} {
+		t.Run(test.query, func(t *testing.T) {
+			env, ast, err := parse(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prog, err := compile(env, ast)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := todo.matches(prog)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Fatalf("matches(%s): got %t, want %t", test.query, got, test.want)
+			}
+		})
+	}
+}
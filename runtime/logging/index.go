@@ -0,0 +1,459 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/cel-go/common/operators"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// This file contains code to index log files, so that queries can skip the
+// parts of log files that cannot contain matching log entries.
+//
+// A FileStore divides every log file into blocks of roughly blockSize bytes.
+// Every block contains a whole number of log entries. When a block is full,
+// the FileStore appends a fixed-size record describing the block to the log
+// file's index file, which has the same name as the log file with an ".idx"
+// suffix. A record contains
+//
+//   - the offset and length of the block in the (uncompressed) log file,
+//   - the minimum and maximum timestamp of the entries in the block, and
+//   - a bloom filter of the attribute names and name/value pairs and of the
+//     trace ids of the entries in the block.
+//
+// The index is sparse: it has one record per block, not per entry. An index
+// covers a prefix of its log file. The last, partially written block of a log
+// file that is still being written is not indexed until it's full or the log
+// file is rotated.
+//
+// Before reading a log file, a reader computes a blockFilter from its query.
+// The blockFilter is a conservative summary of the query: the range of
+// timestamps and the attributes that every matching entry must have. The
+// reader then skips every block whose record doesn't pass the filter.
+
+const (
+	idxSuffix  = ".idx"          // suffix of index files
+	blockSize  = 16 << 10        // target size of a block, in bytes
+	bloomBytes = 256             // size of a block's bloom filter, in bytes
+	bloomBits  = 8 * bloomBytes  // size of a block's bloom filter, in bits
+	bloomHash  = 3               // number of hash functions used by bloom filters
+	recordSize = 32 + bloomBytes // size of an encoded blockRecord, in bytes
+	noTime     = math.MinInt64   // timestamp of a block without entries
+)
+
+// blockRecord is an index record that describes a block of a log file.
+type blockRecord struct {
+	offset int64            // offset of the block
+	length int64            // length of the block, in bytes
+	min    int64            // minimum timestamp, in microseconds
+	max    int64            // maximum timestamp, in microseconds
+	bloom  [bloomBytes]byte // bloom filter of attributes
+}
+
+// encode encodes the record into dst, which must be recordSize bytes long.
+func (r *blockRecord) encode(dst []byte) {
+	binary.LittleEndian.PutUint64(dst[0:], uint64(r.offset))
+	binary.LittleEndian.PutUint64(dst[8:], uint64(r.length))
+	binary.LittleEndian.PutUint64(dst[16:], uint64(r.min))
+	binary.LittleEndian.PutUint64(dst[24:], uint64(r.max))
+	copy(dst[32:], r.bloom[:])
+}
+
+// decode decodes a record from src, which must be recordSize bytes long.
+func (r *blockRecord) decode(src []byte) {
+	r.offset = int64(binary.LittleEndian.Uint64(src[0:]))
+	r.length = int64(binary.LittleEndian.Uint64(src[8:]))
+	r.min = int64(binary.LittleEndian.Uint64(src[16:]))
+	r.max = int64(binary.LittleEndian.Uint64(src[24:]))
+	copy(r.bloom[:], src[32:])
+}
+
+// add adds the provided log entry to the record.
+func (r *blockRecord) add(e *protos.LogEntry) {
+	if r.min == noTime || e.TimeMicros < r.min {
+		r.min = e.TimeMicros
+	}
+	if r.max == noTime || e.TimeMicros > r.max {
+		r.max = e.TimeMicros
+	}
+	for i := 0; i+1 < len(e.Attrs); i += 2 {
+		r.addKey(attrKey(e.Attrs[i]))
+		r.addKey(attrValueKey(e.Attrs[i], e.Attrs[i+1]))
+	}
+	if e.TraceId != "" {
+		r.addKey(traceKey(e.TraceId))
+	}
+}
+
+// addKey adds the provided key to the record's bloom filter.
+func (r *blockRecord) addKey(key string) {
+	for _, bit := range bloomBitsOf(key) {
+		r.bloom[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// mayContain returns whether the record's bloom filter may contain the
+// provided key.
+func (r *blockRecord) mayContain(key string) bool {
+	for _, bit := range bloomBitsOf(key) {
+		if r.bloom[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomBitsOf returns the bloom filter bits of the provided key, using
+// double hashing to derive bloomHash hash functions from a single hash.
+func bloomBitsOf(key string) [bloomHash]uint32 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	sum := h.Sum64()
+	h1, h2 := uint32(sum), uint32(sum>>32)
+	var bits [bloomHash]uint32
+	for i := range bits {
+		bits[i] = (h1 + uint32(i)*h2) % bloomBits
+	}
+	return bits
+}
+
+// attrKey returns the bloom filter key of an attribute name.
+func attrKey(name string) string {
+	return name
+}
+
+// attrValueKey returns the bloom filter key of an attribute name/value pair.
+func attrValueKey(name, value string) string {
+	return name + "\x00" + value
+}
+
+// traceKey returns the bloom filter key of a trace id. Note that a trace key
+// may collide with an attribute key, which only makes the filter less
+// selective.
+func traceKey(id string) string {
+	return "\x00trace_id\x00" + id
+}
+
+// indexWriter writes the index of a log file.
+type indexWriter struct {
+	file    *os.File    // the index file
+	current blockRecord // the block being written
+	entries int         // number of entries in the current block
+}
+
+// newIndexWriter returns a new indexWriter that writes to the provided file.
+func newIndexWriter(file *os.File) *indexWriter {
+	w := &indexWriter{file: file}
+	w.reset(0)
+	return w
+}
+
+// reset starts a new block at the provided offset.
+func (w *indexWriter) reset(offset int64) {
+	w.current = blockRecord{offset: offset, min: noTime, max: noTime}
+	w.entries = 0
+}
+
+// add records that the provided log entry was written to the log file, which
+// now has the provided size. If the current block is full, its record is
+// written to the index file.
+func (w *indexWriter) add(e *protos.LogEntry, size int64) error {
+	w.current.add(e)
+	w.current.length = size - w.current.offset
+	w.entries++
+	if w.current.length < blockSize {
+		return nil
+	}
+	return w.flush()
+}
+
+// flush writes the record of the current block, if it has any entries, to
+// the index file.
+func (w *indexWriter) flush() error {
+	if w.entries == 0 {
+		return nil
+	}
+	var buf [recordSize]byte
+	w.current.encode(buf[:])
+	if _, err := w.file.Write(buf[:]); err != nil {
+		return err
+	}
+	w.reset(w.current.offset + w.current.length)
+	return nil
+}
+
+// indexFilename returns the filename of the index of the provided log file or
+// rotated segment. A compressed segment shares the index of the uncompressed
+// segment, as offsets are offsets into the uncompressed data.
+func indexFilename(filename string) string {
+	return strings.TrimSuffix(filename, gzipSuffix) + idxSuffix
+}
+
+// readIndex reads the index of the provided log file or rotated segment. It
+// returns the index's records and the index's FileInfo, which can be used to
+// check that the index was not replaced while its log file was opened. If the
+// index doesn't exist or is corrupt, readIndex returns no records, and the
+// whole log file has to be read. A trailing partially written record is
+// ignored.
+func readIndex(filename string) ([]blockRecord, os.FileInfo, error) {
+	f, err := os.Open(indexFilename(filename))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, nil, err
+	}
+	records := make([]blockRecord, len(data)/recordSize)
+	var offset int64
+	for i := range records {
+		records[i].decode(data[i*recordSize : (i+1)*recordSize])
+		if records[i].offset != offset || records[i].length <= 0 {
+			return nil, nil, nil
+		}
+		offset += records[i].length
+	}
+	return records, info, nil
+}
+
+// blockFilter is a conservative summary of a query. Every log entry that
+// matches the query has a timestamp in the range [min, max] and has all of
+// the attribute and trace keys in keys.
+type blockFilter struct {
+	min, max int64    // timestamp range, in microseconds
+	keys     []string // required bloom filter keys
+}
+
+// everything is a blockFilter that passes every block.
+var everything = blockFilter{min: math.MinInt64, max: math.MaxInt64}
+
+// passes returns whether the provided block may contain log entries that
+// match the filter.
+func (f blockFilter) passes(r *blockRecord) bool {
+	if r.max < f.min || r.min > f.max {
+		return false
+	}
+	for _, key := range f.keys {
+		if !r.mayContain(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// passesAny returns whether any of the provided blocks passes the filter.
+func (f blockFilter) passesAny(records []blockRecord) bool {
+	for i := range records {
+		if f.passes(&records[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// newBlockFilter returns the blockFilter of a parsed query.
+func newBlockFilter(e *exprpb.Expr) blockFilter {
+	call := e.GetCallExpr()
+	if call == nil {
+		return everything
+	}
+	switch f := call.GetFunction(); f {
+	case operators.LogicalAnd:
+		a, b := newBlockFilter(call.Args[0]), newBlockFilter(call.Args[1])
+		return blockFilter{
+			min:  max(a.min, b.min),
+			max:  min(a.max, b.max),
+			keys: append(append([]string{}, a.keys...), b.keys...),
+		}
+
+	case operators.LogicalOr:
+		a, b := newBlockFilter(call.Args[0]), newBlockFilter(call.Args[1])
+		var keys []string
+		for _, key := range a.keys {
+			for _, other := range b.keys {
+				if key == other {
+					keys = append(keys, key)
+					break
+				}
+			}
+		}
+		return blockFilter{min: min(a.min, b.min), max: max(a.max, b.max), keys: keys}
+
+	case operators.Equals, operators.NotEquals,
+		operators.Less, operators.LessEquals,
+		operators.Greater, operators.GreaterEquals:
+		if _, attr, ok := explodeAttr(call.Args[0]); ok {
+			// An attribute comparison implies that the attribute exists.
+			name := attr.GetConstExpr().GetStringValue()
+			filter := blockFilter{min: everything.min, max: everything.max, keys: []string{attrKey(name)}}
+			if value := call.Args[1].GetConstExpr(); f == operators.Equals && value != nil {
+				if s, ok := value.ConstantKind.(*exprpb.Constant_StringValue); ok {
+					filter.keys = []string{attrValueKey(name, s.StringValue)}
+				}
+			}
+			return filter
+		}
+		if call.Args[0].GetIdentExpr().GetName() == "trace_id" && f == operators.Equals {
+			if id := call.Args[1].GetConstExpr().GetStringValue(); id != "" {
+				return blockFilter{min: everything.min, max: everything.max, keys: []string{traceKey(id)}}
+			}
+			return everything
+		}
+		if call.Args[0].GetIdentExpr().GetName() != "time" {
+			return everything
+		}
+		t, ok := timestampLiteral(call.Args[1])
+		if !ok {
+			return everything
+		}
+		// Note that we round the timestamp conservatively, as entries have
+		// microsecond timestamps.
+		micros := t.UnixMicro()
+		switch f {
+		case operators.Equals:
+			return blockFilter{min: micros, max: micros}
+		case operators.Less, operators.LessEquals:
+			return blockFilter{min: everything.min, max: micros}
+		case operators.Greater, operators.GreaterEquals:
+			return blockFilter{min: micros, max: everything.max}
+		}
+		return everything
+
+	case "contains", "matches", "startsWith", "endsWith":
+		if _, attr, ok := explodeIndex(call.Target); ok {
+			name := attr.GetConstExpr().GetStringValue()
+			return blockFilter{min: everything.min, max: everything.max, keys: []string{attrKey(name)}}
+		}
+		return everything
+
+	case operators.In:
+		if call.Args[1].GetIdentExpr().GetName() != "attrs" {
+			return everything
+		}
+		if name := call.Args[0].GetConstExpr().GetStringValue(); name != "" {
+			return blockFilter{min: everything.min, max: everything.max, keys: []string{attrKey(name)}}
+		}
+		return everything
+
+	default:
+		// A negation may match entries without an attribute, or outside of
+		// a time range, so we can't filter anything.
+		return everything
+	}
+}
+
+// timestampLiteral returns the value of a timestamp literal like
+// `timestamp("2023-01-01T00:00:00Z")`.
+func timestampLiteral(e *exprpb.Expr) (time.Time, bool) {
+	call := e.GetCallExpr()
+	if call == nil || call.GetFunction() != "timestamp" || len(call.Args) != 1 {
+		return time.Time{}, false
+	}
+	s, ok := call.Args[0].GetConstExpr().GetConstantKind().(*exprpb.Constant_StringValue)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, s.StringValue)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// filteredReader reads the blocks of a log file that pass a blockFilter,
+// along with the unindexed suffix of the log file.
+type filteredReader struct {
+	src    io.Reader     // the log file
+	pos    int64         // current offset in src
+	blocks []blockRecord // remaining blocks that pass the filter
+	tail   int64         // offset of the unindexed suffix of src
+}
+
+// newFilteredReader returns a reader that reads the blocks of src, which are
+// described by records, that pass filter. If src is an io.Seeker, skipped
+// blocks are seeked over. Otherwise, they are read and discarded.
+func newFilteredReader(src io.Reader, records []blockRecord, filter blockFilter) *filteredReader {
+	r := &filteredReader{src: src}
+	for _, record := range records {
+		if filter.passes(&record) {
+			r.blocks = append(r.blocks, record)
+		}
+		r.tail = record.offset + record.length
+	}
+	return r
+}
+
+// Read implements the io.Reader interface.
+func (r *filteredReader) Read(p []byte) (int, error) {
+	// Find the end of the range of bytes we're allowed to read.
+	end := int64(-1) // -1 means unbounded
+	for len(r.blocks) > 0 {
+		b := r.blocks[0]
+		if r.pos >= b.offset+b.length {
+			r.blocks = r.blocks[1:]
+			continue
+		}
+		if err := r.skip(b.offset); err != nil {
+			return 0, err
+		}
+		end = b.offset + b.length
+		break
+	}
+	if end == -1 {
+		if err := r.skip(r.tail); err != nil {
+			return 0, err
+		}
+	}
+
+	if end != -1 && int64(len(p)) > end-r.pos {
+		p = p[:end-r.pos]
+	}
+	n, err := r.src.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+// skip skips to the provided offset, if src is before it.
+func (r *filteredReader) skip(offset int64) error {
+	if r.pos >= offset {
+		return nil
+	}
+	if s, ok := r.src.(io.Seeker); ok {
+		if _, err := s.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+	} else if _, err := io.CopyN(io.Discard, r.src, offset-r.pos); err != nil {
+		return err
+	}
+	r.pos = offset
+	return nil
+}
@@ -0,0 +1,253 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protomsg"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestBlockFilter(t *testing.T) {
+	const ts = `timestamp("1970-01-01T00:00:10Z")`
+	const micros = 10_000_000
+	for _, test := range []struct {
+		query Query
+		want  blockFilter
+	}{
+		{`app == "a"`, everything},
+		{`time < ` + ts, blockFilter{min: math.MinInt64, max: micros}},
+		{`time >= ` + ts, blockFilter{min: micros, max: math.MaxInt64}},
+		{`time == ` + ts, blockFilter{min: micros, max: micros}},
+		{`time != ` + ts, everything},
+		{`!(time < ` + ts + `)`, everything},
+		{
+			`time > timestamp("1970-01-01T00:00:05Z") && time < ` + ts,
+			blockFilter{min: 5_000_000, max: micros},
+		},
+		{
+			`time < timestamp("1970-01-01T00:00:05Z") || time > ` + ts,
+			everything,
+		},
+		{
+			`time == timestamp("1970-01-01T00:00:05Z") || time == ` + ts,
+			blockFilter{min: 5_000_000, max: micros},
+		},
+		{
+			`attrs["a"] == "x"`,
+			blockFilter{min: math.MinInt64, max: math.MaxInt64, keys: []string{attrValueKey("a", "x")}},
+		},
+		{
+			`attrs["a"] != "x"`,
+			blockFilter{min: math.MinInt64, max: math.MaxInt64, keys: []string{attrKey("a")}},
+		},
+		{
+			`attrs["a"].contains("x") && "b" in attrs`,
+			blockFilter{min: math.MinInt64, max: math.MaxInt64, keys: []string{attrKey("a"), attrKey("b")}},
+		},
+		{
+			`attrs["a"] == "x" || attrs["a"] == "y"`,
+			everything,
+		},
+		{
+			`("a" in attrs && "b" in attrs) || ("a" in attrs && "c" in attrs)`,
+			blockFilter{min: math.MinInt64, max: math.MaxInt64, keys: []string{attrKey("a")}},
+		},
+		{`!("a" in attrs)`, everything},
+		{
+			`int(attrs["a"]) > 5 && attrs["b"].startsWith("x")`,
+			blockFilter{min: math.MinInt64, max: math.MaxInt64, keys: []string{attrKey("a"), attrKey("b")}},
+		},
+		{
+			`trace_id == "abc" && app == "a"`,
+			blockFilter{min: math.MinInt64, max: math.MaxInt64, keys: []string{traceKey("abc")}},
+		},
+		{`trace_id != "abc"`, everything},
+		{
+			`time > timestamp("1970-01-01T00:00:20Z") - duration("10s")`,
+			blockFilter{min: micros, max: math.MaxInt64},
+		},
+	} {
+		t.Run(test.query, func(t *testing.T) {
+			_, ast, err := parse(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := newBlockFilter(ast.Expr())
+			if diff := cmp.Diff(test.want, got, cmp.AllowUnexported(blockFilter{}), cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("newBlockFilter(%s) (-want +got):\n%s", test.query, diff)
+			}
+		})
+	}
+}
+
+// storeIndexedEntries stores n entries, one second apart, with attributes
+// "parity" and "hundreds", in traces of ten entries.
+func storeIndexedEntries(fs *FileStore, n int) []*protos.LogEntry {
+	var entries []*protos.LogEntry
+	for i := 0; i < n; i++ {
+		parity := "even"
+		if i%2 == 1 {
+			parity = "odd"
+		}
+		e := &protos.LogEntry{
+			App:        "test",
+			Version:    "v1",
+			Node:       "1",
+			Level:      "info",
+			TimeMicros: int64(i+1) * time.Second.Microseconds(),
+			Msg:        strings.Repeat("x", 100) + strconv.Itoa(i),
+			Attrs:      []string{"parity", parity, "hundreds", strconv.Itoa(i / 100)},
+			TraceId:    fmt.Sprintf("trace%d", i/10),
+		}
+		fs.Add(e)
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func TestIndexedQueries(t *testing.T) {
+	for _, opts := range []FileStoreOptions{
+		{},
+		{MaxFileSize: 50_000},
+		{MaxFileSize: 50_000, Compress: true},
+	} {
+		t.Run(fmt.Sprintf("%+v", opts), func(t *testing.T) {
+			logdir = t.TempDir()
+			ctx := ctx(t)
+
+			fs, err := NewFileStoreWithOptions(logdir, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			all := storeIndexedEntries(fs, 2000)
+			if err := fs.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, q := range []Query{
+				`app == "test"`,
+				`time >= timestamp("1970-01-01T00:10:00Z")`,
+				`time < timestamp("1970-01-01T00:00:30Z")`,
+				`time > timestamp("1970-01-01T00:10:00Z") && time <= timestamp("1970-01-01T00:12:00Z")`,
+				`time < timestamp("1970-01-01T00:01:00Z") || time > timestamp("1970-01-01T00:30:00Z")`,
+				`attrs["hundreds"] == "7"`,
+				`attrs["hundreds"] == "7" && attrs["parity"] == "odd"`,
+				`attrs["hundreds"] == "42"`,
+				`!(attrs["hundreds"] == "7")`,
+				`trace_id == "trace42"`,
+				`trace_id == "trace4242"`,
+			} {
+				t.Run(q, func(t *testing.T) {
+					env, ast, err := parse(q)
+					if err != nil {
+						t.Fatal(err)
+					}
+					prog, err := compile(env, ast)
+					if err != nil {
+						t.Fatal(err)
+					}
+					var want []*protos.LogEntry
+					for _, e := range all {
+						ok, err := matches(prog, e)
+						if err != nil {
+							t.Fatal(err)
+						}
+						if ok {
+							want = append(want, e)
+						}
+					}
+					got := drain(t, ctx, cat(t, ctx, q))
+					if diff := cmp.Diff(want, got, protocmp.Transform(), cmpopts.EquateEmpty()); diff != "" {
+						t.Fatalf("bad cat (-want +got):\n%s", diff)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestFilteredReaderSkips(t *testing.T) {
+	logdir = t.TempDir()
+	fs, err := NewFileStore(logdir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	storeIndexedEntries(fs, 2000)
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(logdir, filename("test", "v1", "1", "info"))
+	records, _, err := readIndex(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) < 10 {
+		t.Fatalf("got %d index records, want at least 10", len(records))
+	}
+
+	// Count the entries read from the log file through the index.
+	count := func(q Query) int {
+		_, ast, err := parse(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := os.Open(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		r := bufio.NewReader(newFilteredReader(f, records, newBlockFilter(ast.Expr())))
+		n := 0
+		for {
+			var e protos.LogEntry
+			err := protomsg.Read(r, &e)
+			if errors.Is(err, io.EOF) {
+				return n
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			n++
+		}
+	}
+	if got, want := count(`app == "test"`), 2000; got != want {
+		t.Errorf("got %d entries, want %d", got, want)
+	}
+	for _, q := range []Query{
+		`time >= timestamp("1970-01-01T00:10:00Z") && time < timestamp("1970-01-01T00:11:00Z")`,
+		`attrs["hundreds"] == "7"`,
+		`attrs["hundreds"] == "42"`,
+		`trace_id == "trace42"`,
+	} {
+		if got := count(q); got >= 400 {
+			t.Errorf("%s: got %d entries, want fewer than 400", q, got)
+		}
+	}
+}
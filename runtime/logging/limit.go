@@ -0,0 +1,192 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/metrics"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+var suppressedCounts = metrics.NewCounterMap[suppressedLabels](
+	"serviceweaver_log_entries_suppressed_count",
+	"Count of log entries suppressed by log limits",
+)
+
+type suppressedLabels struct {
+	Component string // full component name
+	Level     string // log level (e.g., "DEBUG")
+}
+
+// A Limiter limits the log entries produced by a single component, as
+// specified by a set of protos.LogLimit. Every log entry is governed by the
+// most specific limit that matches its level (see protos.LogLimit). A limit
+// first samples log entries and then rate limits the sampled entries using a
+// token bucket. Entries that are not kept are suppressed.
+//
+// Sampling is deterministic: a limit with sample 0.1 keeps every tenth log
+// entry. Rate limiting is based on the times of the log entries.
+type Limiter struct {
+	component string
+	byLevel   map[slog.Level]*limitState // limits for specific levels
+	fallback  *limitState                // limit for all other levels, or nil
+
+	mu         sync.Mutex
+	suppressed map[slog.Level]int64 // suppressed entries since last Suppressed
+}
+
+// limitState is the state of a single protos.LogLimit.
+type limitState struct {
+	sample float64 // fraction of entries to keep, or 0 for all
+	rate   float64 // entries per second, or 0 for unlimited
+	burst  float64 // maximum number of tokens
+
+	n      int64     // number of entries seen by the sampler
+	tokens float64   // available tokens
+	last   time.Time // time tokens was last updated
+}
+
+// NewLimiter returns a Limiter for the provided component that applies the
+// provided limits, ignoring the limits for other components. It returns nil if
+// none of the limits apply to the component.
+func NewLimiter(component string, limits []*protos.LogLimit) (*Limiter, error) {
+	// Order the limits from least to most specific, so that more specific
+	// limits override less specific ones.
+	var ordered []*protos.LogLimit
+	for _, specific := range [][2]bool{{false, false}, {false, true}, {true, false}, {true, true}} {
+		for _, limit := range limits {
+			if limit.Component != "" && limit.Component != component {
+				continue
+			}
+			if (limit.Component != "") == specific[0] && (limit.Level != "") == specific[1] {
+				ordered = append(ordered, limit)
+			}
+		}
+	}
+	if len(ordered) == 0 {
+		return nil, nil
+	}
+
+	l := &Limiter{
+		component:  component,
+		byLevel:    map[slog.Level]*limitState{},
+		suppressed: map[slog.Level]int64{},
+	}
+	for _, limit := range ordered {
+		state, err := newLimitState(limit)
+		if err != nil {
+			return nil, err
+		}
+		if limit.Level == "" {
+			if limit.Component != "" {
+				// A limit for the component overrides the limits for
+				// specific levels of all components.
+				clear(l.byLevel)
+			}
+			l.fallback = state
+			continue
+		}
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(limit.Level)); err != nil {
+			return nil, fmt.Errorf("log limit: invalid level %q", limit.Level)
+		}
+		l.byLevel[level] = state
+	}
+	return l, nil
+}
+
+// newLimitState returns the initial state of the provided limit.
+func newLimitState(limit *protos.LogLimit) (*limitState, error) {
+	if limit.Sample < 0 || limit.Sample > 1 {
+		return nil, fmt.Errorf("log limit: sample %v not in the range (0, 1]", limit.Sample)
+	}
+	if limit.Rate < 0 || limit.Burst < 0 {
+		return nil, fmt.Errorf("log limit: negative rate %v or burst %d", limit.Rate, limit.Burst)
+	}
+	burst := float64(limit.Burst)
+	if burst == 0 {
+		burst = math.Max(1, math.Ceil(limit.Rate))
+	}
+	return &limitState{
+		sample: limit.Sample,
+		rate:   limit.Rate,
+		burst:  burst,
+		tokens: burst,
+	}, nil
+}
+
+// Allow returns whether a log entry with the provided level and time should
+// be kept. If not, the entry is counted as suppressed.
+func (l *Limiter) Allow(level slog.Level, now time.Time) bool {
+	state, ok := l.byLevel[level]
+	if !ok {
+		state = l.fallback
+	}
+	if state == nil {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if state.allow(now) {
+		return true
+	}
+	l.suppressed[level]++
+	suppressedCounts.Get(suppressedLabels{Component: l.component, Level: level.String()}).Inc()
+	return false
+}
+
+// allow returns whether an entry logged at the provided time should be kept.
+func (s *limitState) allow(now time.Time) bool {
+	if s.sample > 0 {
+		// Keep the nth entry if it brings the expected number of kept entries
+		// to a new integer. This keeps the first entry, and then one entry for
+		// every 1/sample entries.
+		keep := math.Ceil(float64(s.n)*s.sample) != math.Ceil(float64(s.n+1)*s.sample)
+		s.n++
+		if !keep {
+			return false
+		}
+	}
+
+	if s.rate > 0 {
+		if !s.last.IsZero() && now.After(s.last) {
+			s.tokens = math.Min(s.burst, s.tokens+now.Sub(s.last).Seconds()*s.rate)
+		}
+		if s.last.IsZero() || now.After(s.last) {
+			s.last = now
+		}
+		if s.tokens < 1 {
+			return false
+		}
+		s.tokens--
+	}
+	return true
+}
+
+// Suppressed returns the number of log entries, by level, that were
+// suppressed since the last call to Suppressed.
+func (l *Limiter) Suppressed() map[slog.Level]int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	suppressed := l.suppressed
+	l.suppressed = map[slog.Level]int64{}
+	return suppressed
+}
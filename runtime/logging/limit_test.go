@@ -0,0 +1,144 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLimiterSample(t *testing.T) {
+	limiter, err := NewLimiter("a", []*protos.LogLimit{{Sample: 0.1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	var kept []int
+	for i := 0; i < 100; i++ {
+		if limiter.Allow(slog.LevelInfo, now) {
+			kept = append(kept, i)
+		}
+	}
+	want := []int{0, 10, 20, 30, 40, 50, 60, 70, 80, 90}
+	if diff := cmp.Diff(want, kept); diff != "" {
+		t.Fatalf("kept (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[slog.Level]int64{slog.LevelInfo: 90}, limiter.Suppressed()); diff != "" {
+		t.Fatalf("Suppressed (-want +got):\n%s", diff)
+	}
+	if got := limiter.Suppressed(); len(got) != 0 {
+		t.Fatalf("Suppressed: got %v, want none", got)
+	}
+}
+
+func TestLimiterRate(t *testing.T) {
+	limiter, err := NewLimiter("a", []*protos.LogLimit{{Rate: 10, Burst: 5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := func(now time.Time, n int) int {
+		allowed := 0
+		for i := 0; i < n; i++ {
+			if limiter.Allow(slog.LevelInfo, now) {
+				allowed++
+			}
+		}
+		return allowed
+	}
+
+	now := time.Now()
+	if got, want := count(now, 100), 5; got != want {
+		t.Errorf("initial burst: got %d, want %d", got, want)
+	}
+	if got, want := count(now.Add(300*time.Millisecond), 100), 3; got != want {
+		t.Errorf("after 300ms: got %d, want %d", got, want)
+	}
+	if got, want := count(now.Add(time.Hour), 100), 5; got != want {
+		t.Errorf("after an hour: got %d, want %d", got, want)
+	}
+	if got, want := limiter.Suppressed()[slog.LevelInfo], int64(287); got != want {
+		t.Errorf("Suppressed: got %d, want %d", got, want)
+	}
+}
+
+func TestLimiterPrecedence(t *testing.T) {
+	limits := []*protos.LogLimit{
+		{Rate: 1},                                      // all components, all levels
+		{Level: "debug", Sample: 0.5},                  // all components, debug
+		{Component: "b", Sample: 0.25},                 // b, all levels
+		{Component: "c", Level: "error", Sample: 0.25}, // c, error
+	}
+	for _, test := range []struct {
+		component string
+		level     slog.Level
+		want      int // number of allowed entries out of 100
+	}{
+		{"a", slog.LevelInfo, 1},
+		{"a", slog.LevelDebug, 50},
+		{"b", slog.LevelInfo, 25},
+		{"b", slog.LevelDebug, 25},
+		{"c", slog.LevelError, 25},
+		{"c", slog.LevelDebug, 50},
+		{"c", slog.LevelWarn, 1},
+	} {
+		limiter, err := NewLimiter(test.component, limits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		allowed := 0
+		for i := 0; i < 100; i++ {
+			if limiter.Allow(test.level, now) {
+				allowed++
+			}
+		}
+		if allowed != test.want {
+			t.Errorf("%s/%v: got %d allowed entries, want %d", test.component, test.level, allowed, test.want)
+		}
+	}
+
+	// No limits apply to component d.
+	limiter, err := NewLimiter("d", []*protos.LogLimit{{Component: "a", Rate: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if limiter != nil {
+		t.Fatalf("NewLimiter: got %v, want nil", limiter)
+	}
+}
+
+func TestLimitedHandler(t *testing.T) {
+	limiter, err := NewLimiter("a", []*protos.LogLimit{{Level: "debug", Rate: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msgs []string
+	logger := slog.New(&LogHandler{
+		Write:   func(e *protos.LogEntry) { msgs = append(msgs, e.Msg) },
+		Limiter: limiter,
+	}).With("foo", "bar")
+	for i := 0; i < 3; i++ {
+		logger.Debug("debug")
+		logger.Info("info")
+	}
+	want := []string{"debug", "info", "info", "info"}
+	if diff := cmp.Diff(want, msgs); diff != "" {
+		t.Fatalf("logged messages (-want +got):\n%s", diff)
+	}
+}
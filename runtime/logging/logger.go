@@ -27,6 +27,7 @@ import (
 	"github.com/ServiceWeaver/weaver/runtime/colors"
 	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SystemAttributeKey is present as the key of an attribute (with an empty value)
@@ -57,22 +58,27 @@ type Options struct {
 
 // LogHandler implements a custom slog.Handler.
 type LogHandler struct {
-	Opts  Options                      // configures the log entries
-	Write func(entry *protos.LogEntry) // called on every log entry
+	Opts    Options                      // configures the log entries
+	Write   func(entry *protos.LogEntry) // called on every log entry
+	Level   slog.Leveler                 // minimum level, or nil for all levels
+	Limiter *Limiter                     // limits log entries, or nil for no limits
 }
 
 var _ slog.Handler = &LogHandler{}
 
 // Handle implements the slog.Handler interface.
 func (h *LogHandler) Handle(ctx context.Context, rec slog.Record) error {
-	h.Write(h.makeEntry(rec))
+	if h.Limiter != nil && !h.Limiter.Allow(rec.Level, rec.Time) {
+		return nil
+	}
+	h.Write(h.makeEntry(ctx, rec))
 	return nil
 }
 
 // Enabled implements the slog.Handler interface.
-func (h *LogHandler) Enabled(context.Context, slog.Level) bool {
-	// Support all logging levels.
-	return true
+func (h *LogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	// By default, support all logging levels.
+	return h.Level == nil || level >= h.Level.Level()
 }
 
 // WithAttrs implements the slog.Handler interface.
@@ -83,8 +89,10 @@ func (h *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
 	// Note that WithAttrs results in a new logger, hence we should create a new
 	// handler that contains the new attributes.
 	rh := &LogHandler{
-		Opts:  h.Opts,
-		Write: h.Write,
+		Opts:    h.Opts,
+		Write:   h.Write,
+		Level:   h.Level,
+		Limiter: h.Limiter,
 	}
 	rh.Opts.Attrs = appendAttrs(rh.Opts.Attrs, attrs)
 	return rh
@@ -99,8 +107,8 @@ func (h *LogHandler) WithGroup(string) slog.Handler {
 }
 
 // makeEntry returns an entry that is fully populated with information captured
-// by a log record.
-func (h *LogHandler) makeEntry(rec slog.Record) *protos.LogEntry {
+// by a log record and the trace span, if any, in the record's context.
+func (h *LogHandler) makeEntry(ctx context.Context, rec slog.Record) *protos.LogEntry {
 	// TODO(sanjay): Is it necessary to copy opts.Attrs even if no new attrs
 	// are being added?
 	var attrs []slog.Attr
@@ -129,9 +137,53 @@ func (h *LogHandler) makeEntry(rec slog.Record) *protos.LogEntry {
 		entry.File = frame.File
 		entry.Line = int32(frame.Line)
 	}
+
+	// Get the trace and span ids.
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		entry.TraceId = span.TraceID().String()
+		entry.SpanId = span.SpanID().String()
+	}
 	return &entry
 }
 
+// WithSpan returns a logger that logs every entry as if it were logged in the
+// trace span in ctx, if any. This way, entries logged without a context (e.g.,
+// logger.Info) are still associated with the span. Entries logged with a
+// context that has a span of its own (e.g., logger.InfoContext) are associated
+// with that span instead.
+func WithSpan(ctx context.Context, logger *slog.Logger) *slog.Logger {
+	span := trace.SpanContextFromContext(ctx)
+	if !span.IsValid() {
+		return logger
+	}
+	return slog.New(&spanHandler{Handler: logger.Handler(), span: span})
+}
+
+// spanHandler is a slog.Handler that attaches a trace span to the contexts of
+// the records it handles. See WithSpan.
+type spanHandler struct {
+	slog.Handler
+	span trace.SpanContext
+}
+
+// Handle implements the slog.Handler interface.
+func (h *spanHandler) Handle(ctx context.Context, rec slog.Record) error {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, h.span)
+	}
+	return h.Handler.Handle(ctx, rec)
+}
+
+// WithAttrs implements the slog.Handler interface.
+func (h *spanHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &spanHandler{Handler: h.Handler.WithAttrs(attrs), span: h.span}
+}
+
+// WithGroup implements the slog.Handler interface.
+func (h *spanHandler) WithGroup(name string) slog.Handler {
+	return &spanHandler{Handler: h.Handler.WithGroup(name), span: h.span}
+}
+
 // StderrLogger returns a logger that pretty prints log entries to stderr.
 func StderrLogger(opts Options) *slog.Logger {
 	pp := NewPrettyPrinter(colors.Enabled())
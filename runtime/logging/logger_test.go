@@ -15,6 +15,7 @@
 package logging
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"strconv"
@@ -26,6 +27,7 @@ import (
 	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestTestLogger(t *testing.T) {
@@ -73,6 +75,38 @@ func TestWithAttributes(t *testing.T) {
 	}
 }
 
+func TestTraceIds(t *testing.T) {
+	span := func(traceID, spanID byte) trace.SpanContext {
+		return trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{traceID},
+			SpanID:  trace.SpanID{spanID},
+		})
+	}
+	outer := trace.ContextWithSpanContext(context.Background(), span(1, 2))
+	inner := trace.ContextWithSpanContext(context.Background(), span(3, 4))
+
+	var got [][2]string
+	logSaver := func(e *protos.LogEntry) {
+		got = append(got, [2]string{e.TraceId, e.SpanId})
+	}
+	logger := newAttrLogger("app", "version", "component", "weavelet", logSaver)
+	logger.Info("no span")
+	logger.InfoContext(inner, "inner span")
+	spanLogger := WithSpan(outer, logger).With("foo", "bar")
+	spanLogger.Info("outer span")
+	spanLogger.InfoContext(inner, "inner span")
+
+	want := [][2]string{
+		{"", ""},
+		{"03000000000000000000000000000000", "0400000000000000"},
+		{"01000000000000000000000000000000", "0200000000000000"},
+		{"03000000000000000000000000000000", "0400000000000000"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected trace ids (-want +got):\n%s", diff)
+	}
+}
+
 func TestConcurrentAttributes(t *testing.T) {
 	// Test plan: start a number of goroutines that emit attributes with sequential
 	// values. Confirm that attributes are saved in the same sequential order
@@ -50,19 +50,26 @@ import (
 //   - level: string
 //   - source: string
 //   - msg: string
+//   - trace_id: string
+//   - span_id: string
 //   - attrs: map[string]string
 //
 // A query is restricted to:
 //
 //   - boolean algebra (!, &&, ||),
 //   - equalities and inequalities (==, !=, <, <=, >, >=),
-//   - the string operations "contains" and "matches",
-//   - map indexing (attrs["foo"]) and membership ("foo" in attrs), and
-//   - constant strings, timestamps, and ints.
+//   - the string operations "contains", "matches", "startsWith", and
+//     "endsWith",
+//   - map indexing (attrs["foo"]) and membership ("foo" in attrs),
+//   - the conversions int, double, and duration of attributes (e.g.,
+//     int(attrs["foo"])),
+//   - constant strings, timestamps, durations, ints, and doubles, and
+//   - the current time, now, and the addition and subtraction of durations to
+//     and from timestamps (e.g., now - duration("15m")).
 //
-// All equalities and inequalities must look like `app == "todo"` or
-// `attrs["foo"] == "bar"`; i.e. a field or attribute on the left and a constant
-// on the right.
+// All equalities and inequalities must look like `app == "todo"`,
+// `attrs["foo"] == "bar"`, or `int(attrs["foo"]) > 10`; i.e. a field or
+// attribute on the left and a constant on the right.
 //
 // # Semantics
 //
@@ -76,6 +83,13 @@ import (
 //
 //	"foo" in attrs && attrs["foo"] == "bar"
 //
+// A conversion of an attribute that fails, like int(attrs["foo"]) when the
+// value of "foo" is not an integer, doesn't match.
+//
+// The current time, now, is the time the query is parsed. A query like
+// `time > now - duration("15m")` that follows logs keeps matching the log
+// entries logged after the 15 minutes before it started.
+//
 // TODO(mwhittaker): Expand the set of valid queries. For example, we can allow
 // more constant expressions on the right hand side of a comparison. We can
 // also allow fields on the right and constants on the left.
@@ -112,7 +126,10 @@ func env() (*cel.Env, error) {
 		decls.NewVar("level", decls.String),
 		decls.NewVar("source", decls.String),
 		decls.NewVar("msg", decls.String),
+		decls.NewVar("trace_id", decls.String),
+		decls.NewVar("span_id", decls.String),
 		decls.NewVar("attrs", decls.NewMapType(decls.String, decls.String)),
+		decls.NewVar("now", decls.Timestamp),
 	))
 }
 
@@ -144,6 +161,22 @@ func parse(query Query) (*cel.Env, *cel.Ast, error) {
 		return nil, nil, fmt.Errorf("Parse(%s) restriction error: %w", query, err)
 	}
 
+	// Resolve relative times, if any, and parse the resolved query again.
+	e, resolved, err := resolve(ast.Expr(), time.Now())
+	if err != nil {
+		return nil, nil, fmt.Errorf("Parse(%s) resolution error: %w", query, err)
+	}
+	if !resolved {
+		return env, ast, nil
+	}
+	q, err := format(e)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Parse(%s) format error: %w", query, err)
+	}
+	ast, issues = env.Compile(q)
+	if issues != nil && issues.Err() != nil {
+		return nil, nil, fmt.Errorf("Parse(%s) compilation error: %w", query, issues.Err())
+	}
 	return env, ast, nil
 }
 
@@ -193,8 +226,8 @@ func restrictCall(e *exprpb.Expr_Call) error {
 		}
 		return restrictLiteral(e.Args[1])
 
-	// contains, matches
-	case "contains", "matches":
+	// contains, matches, startsWith, endsWith
+	case "contains", "matches", "startsWith", "endsWith":
 		if err := restrictField(e.Target); err != nil {
 			return err
 		}
@@ -212,14 +245,27 @@ func restrictCall(e *exprpb.Expr_Call) error {
 	}
 }
 
+// conversions are the functions that can be applied to attributes.
+var conversions = map[string]bool{"int": true, "double": true, "duration": true}
+
 // restrictField checks whether the provided expression is a log entry field,
-// either an identifier like `line` or an attribute expression like `attrs["foo"]`.
+// either an identifier like `line`, an attribute expression like
+// `attrs["foo"]`, or a conversion of an attribute like `int(attrs["foo"])`.
 func restrictField(e *exprpb.Expr) error {
 	switch t := e.ExprKind.(type) {
 	case *exprpb.Expr_IdentExpr:
+		if t.IdentExpr.Name == "now" {
+			return fmt.Errorf("unsupported field: %v", e)
+		}
 		return nil
 	case *exprpb.Expr_CallExpr:
 		fn := t.CallExpr.Function
+		if conversions[fn] && t.CallExpr.Target == nil && len(t.CallExpr.Args) == 1 {
+			if _, _, ok := explodeIndex(t.CallExpr.Args[0]); !ok {
+				return fmt.Errorf("unsupported %s argument, want an attribute, got %v", fn, t.CallExpr.Args[0])
+			}
+			return restrictField(t.CallExpr.Args[0])
+		}
 		if fn == operators.Index { // Map [] operator.
 			if tg := t.CallExpr.Args[0].GetIdentExpr(); tg == nil || tg.GetName() != "attrs" {
 				return fmt.Errorf(`unsupported map target, want "attrs", got %v`, t.CallExpr.Args[0])
@@ -236,22 +282,162 @@ func restrictField(e *exprpb.Expr) error {
 }
 
 // restrictLiteral checks whether the provided expression is a literal (e.g.,
-// 42, "foo").
+// 42, "foo", duration("1h"), now - duration("1h")).
 func restrictLiteral(e *exprpb.Expr) error {
 	switch e.ExprKind.(type) {
 	case *exprpb.Expr_ConstExpr:
 		return nil
+	case *exprpb.Expr_IdentExpr:
+		if e.GetIdentExpr().Name != "now" {
+			return fmt.Errorf("unsupported literal: %v", e)
+		}
+		return nil
 	case *exprpb.Expr_CallExpr:
 		call := e.GetCallExpr()
-		if f := call.Function; f != "timestamp" {
+		switch call.Function {
+		case "timestamp", "duration":
+			if call.Args[0].GetConstExpr() == nil {
+				return fmt.Errorf("unsupported %s argument, want a constant, got %v", call.Function, call.Args[0])
+			}
+			return nil
+		case operators.Add, operators.Subtract:
+			for i := 0; i < 2; i++ {
+				if err := restrictLiteral(call.Args[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		default:
 			return fmt.Errorf("unsupported literal: %v", e)
 		}
-		return restrictLiteral(call.Args[0])
 	default:
 		return fmt.Errorf("unsupported literal: %v", e)
 	}
 }
 
+// resolve replaces every relative time in a restricted expression, like
+// `now - duration("1h")`, with the absolute timestamp it denotes, like
+// `timestamp("2023-01-01T11:00:00Z")`. resolve also evaluates the addition
+// and subtraction of constant timestamps and durations. resolve returns
+// whether it replaced anything. The provided expression is not modified.
+func resolve(e *exprpb.Expr, now time.Time) (*exprpb.Expr, bool, error) {
+	call := e.GetCallExpr()
+	if call == nil {
+		return e, false, nil
+	}
+	switch call.Function {
+	case operators.LogicalNot, operators.LogicalAnd, operators.LogicalOr:
+		args := make([]*exprpb.Expr, len(call.Args))
+		resolved := false
+		for i, arg := range call.Args {
+			sub, ok, err := resolve(arg, now)
+			if err != nil {
+				return nil, false, err
+			}
+			args[i] = sub
+			resolved = resolved || ok
+		}
+		if !resolved {
+			return e, false, nil
+		}
+		return callexpr(&exprpb.Expr_Call{Function: call.Function, Args: args}), true, nil
+
+	case operators.Equals, operators.NotEquals,
+		operators.Less, operators.LessEquals,
+		operators.Greater, operators.GreaterEquals:
+		if !relative(call.Args[1]) {
+			return e, false, nil
+		}
+		v, err := evalLiteral(call.Args[1], now)
+		if err != nil {
+			return nil, false, err
+		}
+		var lit *exprpb.Expr
+		switch v := v.(type) {
+		case time.Time:
+			lit = callexpr(&exprpb.Expr_Call{Function: "timestamp", Args: []*exprpb.Expr{strexpr(v.UTC().Format(time.RFC3339Nano))}})
+		case time.Duration:
+			lit = callexpr(&exprpb.Expr_Call{Function: "duration", Args: []*exprpb.Expr{strexpr(v.String())}})
+		default:
+			return nil, false, fmt.Errorf("unexpected literal value %v", v)
+		}
+		return callexpr(binop(call.Args[0], call.Function, lit)), true, nil
+
+	default:
+		return e, false, nil
+	}
+}
+
+// relative returns whether the provided literal refers to now or adds or
+// subtracts times.
+func relative(e *exprpb.Expr) bool {
+	if e.GetIdentExpr() != nil {
+		return true
+	}
+	switch e.GetCallExpr().GetFunction() {
+	case operators.Add, operators.Subtract:
+		return true
+	default:
+		return false
+	}
+}
+
+// evalLiteral evaluates a restricted time literal to a time.Time or
+// time.Duration.
+func evalLiteral(e *exprpb.Expr, now time.Time) (any, error) {
+	if e.GetIdentExpr() != nil {
+		return now, nil
+	}
+	call := e.GetCallExpr()
+	switch f := call.GetFunction(); f {
+	case "timestamp":
+		return time.Parse(time.RFC3339, call.Args[0].GetConstExpr().GetStringValue())
+	case "duration":
+		return time.ParseDuration(call.Args[0].GetConstExpr().GetStringValue())
+	case operators.Add, operators.Subtract:
+		x, err := evalLiteral(call.Args[0], now)
+		if err != nil {
+			return nil, err
+		}
+		y, err := evalLiteral(call.Args[1], now)
+		if err != nil {
+			return nil, err
+		}
+		if f == operators.Subtract {
+			switch y := y.(type) {
+			case time.Duration:
+				return add(x, -y)
+			case time.Time:
+				if x, ok := x.(time.Time); ok {
+					return x.Sub(y), nil
+				}
+			}
+			return nil, fmt.Errorf("unsupported subtraction: %v", e)
+		}
+		return add(x, y)
+	default:
+		return nil, fmt.Errorf("unsupported time literal: %v", e)
+	}
+}
+
+// add adds two time literals, at least one of which must be a duration.
+func add(x, y any) (any, error) {
+	switch x := x.(type) {
+	case time.Time:
+		if y, ok := y.(time.Duration); ok {
+			return x.Add(y), nil
+		}
+	case time.Duration:
+		switch y := y.(type) {
+		case time.Time:
+			return y.Add(x), nil
+		case time.Duration:
+			return x + y, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported addition of %v and %v", x, y)
+}
+
 // rewrite rewrites an expression parsed from a query into a CEL expression
 // with the same semantics as the query. Specifically, binary expressions over
 // attributes, like `attrs["foo"] == "bar"`, are translated to include an implicit
@@ -298,7 +484,7 @@ func rewriteCall(e *exprpb.Expr_Call) (*exprpb.Expr_Call, error) {
 	case operators.Equals, operators.NotEquals,
 		operators.Less, operators.LessEquals,
 		operators.Greater, operators.GreaterEquals:
-		attrs, attr, ok := explodeIndex(e.Args[0])
+		attrs, attr, ok := explodeAttr(e.Args[0])
 		if !ok {
 			// There is no attrs["foo"] expression, so we don't have to
 			// rewrite the expression.
@@ -308,8 +494,8 @@ func rewriteCall(e *exprpb.Expr_Call) (*exprpb.Expr_Call, error) {
 		contains := callexpr(binop(attr, operators.In, attrs))
 		return binop(contains, operators.LogicalAnd, callexpr(e)), nil
 
-	// contains, matches
-	case "contains", "matches":
+	// contains, matches, startsWith, endsWith
+	case "contains", "matches", "startsWith", "endsWith":
 		attrs, attr, ok := explodeIndex(e.Target)
 		if !ok {
 			return e, nil
@@ -336,6 +522,21 @@ func binop(lhs *exprpb.Expr, op string, rhs *exprpb.Expr) *exprpb.Expr_Call {
 	return &exprpb.Expr_Call{Function: op, Args: []*exprpb.Expr{lhs, rhs}}
 }
 
+// strexpr returns a string constant expression.
+func strexpr(s string) *exprpb.Expr {
+	return &exprpb.Expr{ExprKind: &exprpb.Expr_ConstExpr{ConstExpr: &exprpb.Constant{ConstantKind: &exprpb.Constant_StringValue{StringValue: s}}}}
+}
+
+// explodeAttr is like explodeIndex, but it also deconstructs conversions of
+// index expressions. For example, both `attrs["foo"]` and `int(attrs["foo"])`
+// return `attrs, "foo", true`.
+func explodeAttr(e *exprpb.Expr) (*exprpb.Expr, *exprpb.Expr, bool) {
+	if call := e.GetCallExpr(); call != nil && conversions[call.Function] && len(call.Args) == 1 {
+		return explodeIndex(call.Args[0])
+	}
+	return explodeIndex(e)
+}
+
 // explodeIndex deconstructs an index expression. If the provided expression e
 // has the form m[k], then it returns m, k, true. Otherwise, it returns nil,
 // nil, false. For example, `attrs["foo"]` returns `attrs, "foo", true`.
@@ -383,6 +584,9 @@ func formatCall(w io.Writer, e *exprpb.Expr_Call) error {
 	ops := map[string]string{
 		operators.LogicalNot:    "!",
 		"timestamp":             "timestamp",
+		"duration":              "duration",
+		"int":                   "int",
+		"double":                "double",
 		operators.LogicalAnd:    "&&",
 		operators.LogicalOr:     "||",
 		operators.Equals:        "==",
@@ -395,8 +599,8 @@ func formatCall(w io.Writer, e *exprpb.Expr_Call) error {
 	}
 
 	switch f := e.GetFunction(); f {
-	// !, timestamp
-	case operators.LogicalNot, "timestamp":
+	// !, timestamp, duration, int, double
+	case operators.LogicalNot, "timestamp", "duration", "int", "double":
 		fmt.Fprint(w, ops[f])
 		return formatExpr(w, e.Args[0])
 
@@ -422,8 +626,8 @@ func formatCall(w io.Writer, e *exprpb.Expr_Call) error {
 		fmt.Fprintf(w, "]")
 		return err
 
-	// contains, matches
-	case "contains", "matches":
+	// contains, matches, startsWith, endsWith
+	case "contains", "matches", "startsWith", "endsWith":
 		if err := formatExpr(w, e.Target); err != nil {
 			return err
 		}
@@ -444,6 +648,17 @@ func formatConst(w io.Writer, c *exprpb.Constant) error {
 	case *exprpb.Constant_Int64Value:
 		fmt.Fprint(w, strconv.FormatInt(c.GetInt64Value(), 10))
 		return nil
+	case *exprpb.Constant_Uint64Value:
+		fmt.Fprint(w, strconv.FormatUint(c.GetUint64Value(), 10), "u")
+		return nil
+	case *exprpb.Constant_DoubleValue:
+		s := strconv.FormatFloat(c.GetDoubleValue(), 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			// Make sure the constant isn't parsed as an int.
+			s += ".0"
+		}
+		fmt.Fprint(w, s)
+		return nil
 	case *exprpb.Constant_StringValue:
 		fmt.Fprint(w, strconv.Quote(c.GetStringValue()))
 		return nil
@@ -497,6 +712,8 @@ func matches(prog cel.Program, entry *protos.LogEntry) (bool, error) {
 		"level":          entry.Level,
 		"source":         fmt.Sprintf("%s:%d", entry.File, entry.Line),
 		"msg":            entry.Msg,
+		"trace_id":       entry.TraceId,
+		"span_id":        entry.SpanId,
 		"attrs":          attrs,
 	})
 	if err != nil {
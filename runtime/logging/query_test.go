@@ -32,6 +32,16 @@ func TestValidQueries(t *testing.T) {
 		`attrs["name"].contains("foo")`,
 		`"foo" in attrs`,
 		`time < timestamp("1972-01-01T10:00:20.021-05:00")`,
+		`time > now - duration("15m")`,
+		`time <= now`,
+		`time >= timestamp("1972-01-01T10:00:20Z") + duration("1h30m")`,
+		`msg.startsWith("error")`,
+		`trace_id == "4bf92f3577b34da6a3ce929d0e0e4736"`,
+		`span_id != ""`,
+		`attrs["path"].endsWith(".go")`,
+		`int(attrs["code"]) >= 500`,
+		`double(attrs["ratio"]) < 0.5`,
+		`duration(attrs["latency"]) > duration("100ms")`,
 		`app == "todo" && version == "v1"`,
 		`app == "todo" && full_version == "v1"`,
 		`app == "todo" || app == "collatz"`,
@@ -82,10 +92,16 @@ func TestInvalidQueries(t *testing.T) {
 		`source in attrs`,
 		`attrs["foo"] in attrs`,
 		`timestamp("1972-01-01T10:00:20.021-05:00") > time`,
+		`now > timestamp("1972-01-01T10:00:20.021-05:00")`,
+		`int(app) > 10`,
+		`int(attrs["foo"]) + 1 > 10`,
 
 		// Bad RHS.
 		`source == source`,
 		`attrs["foo"] == attrs["foo"]`,
+		`time > time - duration("1h")`,
+		`time > timestamp(app)`,
+		`int(attrs["foo"]) > int(attrs["bar"])`,
 
 		// Unsupported root operations.
 		`true`,   // bool
@@ -134,6 +150,12 @@ func TestRewrite(t *testing.T) {
 		{`app == "todo" || attrs["foo"] == "bar"`, `app == "todo" || "foo" in attrs && attrs["foo"] == "bar"`},
 		{`!(app == "todo")`, `!(app == "todo")`},
 		{`!(attrs["foo"] == "bar")`, `!("foo" in attrs && attrs["foo"] == "bar")`},
+		{`msg.startsWith("a") || msg.endsWith("b")`, `msg.startsWith("a") || msg.endsWith("b")`},
+		{`attrs["path"].endsWith(".go")`, `"path" in attrs && attrs["path"].endsWith(".go")`},
+		{`int(attrs["code"]) >= 500`, `"code" in attrs && int(attrs["code"]) >= 500`},
+		{`double(attrs["ratio"]) < 0.5`, `"ratio" in attrs && double(attrs["ratio"]) < 0.5`},
+		{`double(attrs["ratio"]) < 2.0`, `"ratio" in attrs && double(attrs["ratio"]) < 2.0`},
+		{`duration(attrs["latency"]) > duration("100ms")`, `"latency" in attrs && duration(attrs["latency"]) > duration("100ms")`},
 	} {
 		t.Run(test.query, func(t *testing.T) {
 			env, ast, err := parse(test.query)
@@ -163,6 +185,62 @@ func TestRewrite(t *testing.T) {
 	}
 }
 
+func TestResolve(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		query Query
+		want  string
+	}{
+		{`app == "todo"`, `app == "todo"`},
+		{`time > timestamp("2023-01-01T00:00:00Z")`, `time > timestamp("2023-01-01T00:00:00Z")`},
+		{`time <= now`, `time <= timestamp("2023-06-01T12:00:00Z")`},
+		{`time > now - duration("15m")`, `time > timestamp("2023-06-01T11:45:00Z")`},
+		{`time > duration("1h") + now`, `time > timestamp("2023-06-01T13:00:00Z")`},
+		{
+			`time >= timestamp("2023-01-01T00:00:00Z") + duration("1h") + duration("30m")`,
+			`time >= timestamp("2023-01-01T01:30:00Z")`,
+		},
+		{
+			`app == "todo" && !(time < now - duration("1h"))`,
+			`app == "todo" && !(time < timestamp("2023-06-01T11:00:00Z"))`,
+		},
+		{
+			`duration(attrs["latency"]) > now - timestamp("2023-06-01T11:59:59Z")`,
+			`duration(attrs["latency"]) > duration("1s")`,
+		},
+	} {
+		t.Run(test.query, func(t *testing.T) {
+			env, err := env()
+			if err != nil {
+				t.Fatal(err)
+			}
+			ast, issues := env.Compile(test.query)
+			if issues != nil && issues.Err() != nil {
+				t.Fatal(issues.Err())
+			}
+			e, _, err := resolve(ast.Expr(), now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			q, err := format(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ast, issues = env.Compile(q)
+			if issues != nil && issues.Err() != nil {
+				t.Fatal(issues.Err())
+			}
+			got, err := parser.Unparse(ast.Expr(), ast.SourceInfo())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Fatalf("bad resolve: got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
 // at returns a time `seconds` seconds after 2000-01-01.
 // The return value is microseconds since the epich.
 func at(seconds int) int64 {
@@ -315,6 +393,35 @@ func TestQueryMatches(t *testing.T) {
 		{"Attrs/NotOfEqualMissing", `!(attrs["foo"]=="bar")`, &protos.LogEntry{Attrs: []string{}}, true},
 		{"Attrs/In", `"foo" in attrs`, &protos.LogEntry{Attrs: []string{"foo", "bar"}}, true},
 		{"Attrs/NotIn", `"foo" in attrs`, &protos.LogEntry{Attrs: []string{}}, false},
+		{"Attrs/StartsWith", `attrs["foo"].startsWith("b")`, &protos.LogEntry{Attrs: []string{"foo", "bar"}}, true},
+		{"Attrs/EndsWith", `attrs["foo"].endsWith("b")`, &protos.LogEntry{Attrs: []string{"foo", "bar"}}, false},
+
+		// Attr conversions.
+		{"Int/Greater", `int(attrs["n"]) > 9`, &protos.LogEntry{Attrs: []string{"n", "10"}}, true},
+		{"Int/NotGreater", `int(attrs["n"]) > 10`, &protos.LogEntry{Attrs: []string{"n", "10"}}, false},
+		{"Int/Missing", `int(attrs["n"]) > 9`, &protos.LogEntry{}, false},
+		{"Int/Invalid", `int(attrs["n"]) > 9`, &protos.LogEntry{Attrs: []string{"n", "ten"}}, false},
+		{"Int/NotOfInvalid", `app == "a" && !(int(attrs["n"]) > 9)`, &protos.LogEntry{App: "a", Attrs: []string{"n", "ten"}}, false},
+		{"Double/Less", `double(attrs["x"]) < 0.5`, &protos.LogEntry{Attrs: []string{"x", "0.25"}}, true},
+		{"Double/NotLess", `double(attrs["x"]) < 0.5`, &protos.LogEntry{Attrs: []string{"x", "1e3"}}, false},
+		{"Duration/Greater", `duration(attrs["d"]) > duration("100ms")`, &protos.LogEntry{Attrs: []string{"d", "1.5s"}}, true},
+		{"Duration/NotGreater", `duration(attrs["d"]) > duration("100ms")`, &protos.LogEntry{Attrs: []string{"d", "20ms"}}, false},
+
+		// Relative times.
+		{"Now/Recent", `time > now - duration("1h")`, &protos.LogEntry{TimeMicros: time.Now().UnixMicro()}, true},
+		{"Now/Old", `time > now - duration("1h")`, &protos.LogEntry{TimeMicros: at(0)}, false},
+		{"Now/Future", `time <= now`, &protos.LogEntry{TimeMicros: time.Now().Add(time.Hour).UnixMicro()}, false},
+
+		// Messages.
+		{"Msg/Matches", `msg.matches("timeout.*after [0-9]+s")`, &protos.LogEntry{Msg: "timeout: gave up after 30s"}, true},
+		{"Msg/NotMatches", `msg.matches("^timeout")`, &protos.LogEntry{Msg: "request timeout"}, false},
+		{"Msg/StartsWith", `msg.startsWith("request")`, &protos.LogEntry{Msg: "request timeout"}, true},
+
+		// Traces.
+		{"Trace/Matches", `trace_id == "abc"`, &protos.LogEntry{TraceId: "abc", SpanId: "def"}, true},
+		{"Trace/NotMatches", `trace_id == "abc"`, &protos.LogEntry{TraceId: "xyz", SpanId: "def"}, false},
+		{"Trace/Missing", `trace_id == "abc"`, &protos.LogEntry{}, false},
+		{"Trace/Span", `span_id == "def"`, &protos.LogEntry{TraceId: "abc", SpanId: "def"}, true},
 
 		// version vs full_version.
 		{"Version/1", `version=="1"`, &protos.LogEntry{Version: "1"}, true},
@@ -45,7 +45,7 @@ func TestDeployerVersion(t *testing.T) {
 	got := fmt.Sprintf("%x", h.Sum(nil))
 
 	// If runtime.proto has changed, the deployer API version may need updating.
-	const want = "0aeca2daccf70c392639a8c407a030cfe5e4f38b169d54cd5cf24cdd2dc93016"
+	const want = "edba7c07fee29cc3ad06eba6436920008992efde457c2a118e25b90bfca85b08"
 	if got != want {
 		t.Fatalf(`Unexpected SHA-256 hash of runtime.proto: got %s, want %s. If this change is meaningful, REMEMBER TO UPDATE THE DEPLOYER API VERSION in runtime/version/version.go.`, got, want)
 	}
@@ -0,0 +1,199 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tool
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/colors"
+	"github.com/ServiceWeaver/weaver/runtime/logging"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// attrsPrefix is the prefix of the --count-by keys that refer to attributes.
+// For example, "attrs.foo" refers to attribute "foo".
+const attrsPrefix = "attrs."
+
+// fields maps the --count-by keys that refer to log entry fields to functions
+// that extract the fields from log entries.
+var fields = map[string]func(*protos.LogEntry) string{
+	"app":            func(e *protos.LogEntry) string { return e.App },
+	"version":        func(e *protos.LogEntry) string { return logging.Shorten(e.Version) },
+	"full_version":   func(e *protos.LogEntry) string { return e.Version },
+	"component":      func(e *protos.LogEntry) string { return logging.ShortenComponent(e.Component) },
+	"full_component": func(e *protos.LogEntry) string { return e.Component },
+	"node":           func(e *protos.LogEntry) string { return logging.Shorten(e.Node) },
+	"full_node":      func(e *protos.LogEntry) string { return e.Node },
+	"level":          func(e *protos.LogEntry) string { return e.Level },
+	"source":         func(e *protos.LogEntry) string { return fmt.Sprintf("%s:%d", e.File, e.Line) },
+	"msg":            func(e *protos.LogEntry) string { return e.Msg },
+	"trace_id":       func(e *protos.LogEntry) string { return e.TraceId },
+}
+
+// aggregator counts log entries, grouped by a set of keys and, optionally, by
+// time bucket.
+type aggregator struct {
+	keys    []string                        // --count-by keys
+	extract []func(*protos.LogEntry) string // extract[i] extracts keys[i]
+	bucket  time.Duration                   // --bucket, or 0 for no buckets
+	top     int                             // --top, or 0 for all groups
+	counts  map[string]*group               // groups, keyed by encoded group key
+}
+
+// group is a set of log entries with the same keys and time bucket.
+type group struct {
+	bucket time.Time // start of the time bucket, if bucketing
+	values []string  // values of the --count-by keys
+	count  int       // number of log entries
+}
+
+// newAggregator returns a new aggregator. keys is a comma-separated list of
+// log entry fields (e.g., "component") and attributes (e.g., "attrs.foo").
+func newAggregator(keys string, bucket time.Duration, top int) (*aggregator, error) {
+	if bucket < 0 {
+		return nil, fmt.Errorf("invalid --bucket %v: must be positive", bucket)
+	}
+	if top < 0 {
+		return nil, fmt.Errorf("invalid --top %d: must be positive", top)
+	}
+	a := &aggregator{bucket: bucket, top: top, counts: map[string]*group{}}
+	if keys == "" {
+		return a, nil
+	}
+	for _, key := range strings.Split(keys, ",") {
+		key = strings.TrimSpace(key)
+		if f, ok := fields[key]; ok {
+			a.keys = append(a.keys, key)
+			a.extract = append(a.extract, f)
+			continue
+		}
+		name, ok := strings.CutPrefix(key, attrsPrefix)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --count-by key %q: must be a field like %q or an attribute like %q", key, "component", "attrs.foo")
+		}
+		a.keys = append(a.keys, key)
+		a.extract = append(a.extract, func(e *protos.LogEntry) string {
+			for i := 0; i+1 < len(e.Attrs); i += 2 {
+				if e.Attrs[i] == name {
+					return e.Attrs[i+1]
+				}
+			}
+			return ""
+		})
+	}
+	return a, nil
+}
+
+// add adds a log entry to the aggregator.
+func (a *aggregator) add(e *protos.LogEntry) {
+	g := group{values: make([]string, len(a.extract))}
+	for i, extract := range a.extract {
+		g.values[i] = extract(e)
+	}
+	if a.bucket > 0 {
+		g.bucket = time.UnixMicro(e.TimeMicros).Truncate(a.bucket)
+	}
+
+	// Note that we quote values, so that the encoded key is unambiguous.
+	var b strings.Builder
+	fmt.Fprint(&b, g.bucket.UnixMicro())
+	for _, v := range g.values {
+		fmt.Fprintf(&b, " %q", v)
+	}
+	key := b.String()
+
+	if existing, ok := a.counts[key]; ok {
+		existing.count++
+		return
+	}
+	g.count = 1
+	a.counts[key] = &g
+}
+
+// groups returns the aggregated groups. Without buckets, groups are sorted by
+// decreasing count. With buckets, groups are sorted by time bucket and then
+// by decreasing count. If top is positive, only the top groups by count are
+// returned (per bucket, if bucketing).
+func (a *aggregator) groups() []*group {
+	groups := make([]*group, 0, len(a.counts))
+	for _, g := range a.counts {
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		x, y := groups[i], groups[j]
+		if !x.bucket.Equal(y.bucket) {
+			return x.bucket.Before(y.bucket)
+		}
+		if x.count != y.count {
+			return x.count > y.count
+		}
+		for k := range x.values {
+			if x.values[k] != y.values[k] {
+				return x.values[k] < y.values[k]
+			}
+		}
+		return false
+	})
+	if a.top <= 0 {
+		return groups
+	}
+
+	var top []*group
+	n := 0
+	for i, g := range groups {
+		if i > 0 && !g.bucket.Equal(groups[i-1].bucket) {
+			n = 0
+		}
+		if n < a.top {
+			top = append(top, g)
+		}
+		n++
+	}
+	return top
+}
+
+// write writes the aggregated groups as a table.
+func (a *aggregator) write(w io.Writer) {
+	title := []colors.Text{{{S: "LOG COUNTS", Bold: true}}}
+	t := colors.NewTabularizer(w, title, colors.PrefixDim)
+	defer t.Flush()
+
+	var header []any
+	if a.bucket > 0 {
+		header = append(header, "TIME")
+	}
+	for _, key := range a.keys {
+		header = append(header, strings.ToUpper(key))
+	}
+	header = append(header, "COUNT")
+	t.Row(header...)
+
+	for _, g := range a.groups() {
+		var row []any
+		if a.bucket > 0 {
+			row = append(row, g.bucket.Format("2006-01-02 15:04:05"))
+		}
+		for _, v := range g.values {
+			// Keep multi-line values, like stack traces, on one row.
+			row = append(row, strings.ReplaceAll(v, "\n", `\n`))
+		}
+		row = append(row, fmt.Sprint(g.count))
+		t.Row(row...)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tool
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAggregator(t *testing.T) {
+	start := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) int64 { return start.Add(d).UnixMicro() }
+	entries := []*protos.LogEntry{
+		{Component: "a/b/c/Store", Level: "error", TimeMicros: at(0), Msg: "timeout", Attrs: []string{"path", "/add"}},
+		{Component: "a/b/c/Store", Level: "error", TimeMicros: at(10 * time.Second), Msg: "timeout", Attrs: []string{"path", "/list"}},
+		{Component: "a/b/c/Cache", Level: "error", TimeMicros: at(20 * time.Second), Msg: "miss"},
+		{Component: "a/b/c/Store", Level: "info", TimeMicros: at(70 * time.Second), Msg: "timeout", Attrs: []string{"path", "/add"}},
+		{Component: "a/b/c/Cache", Level: "error", TimeMicros: at(80 * time.Second), Msg: "miss"},
+		{Component: "a/b/c/Cache", Level: "error", TimeMicros: at(90 * time.Second), Msg: "miss"},
+	}
+
+	type row struct {
+		Bucket time.Time
+		Values []string
+		Count  int
+	}
+	for _, test := range []struct {
+		name   string
+		keys   string
+		bucket time.Duration
+		top    int
+		want   []row
+	}{
+		{"Total", "", 0, 0, []row{{Values: []string{}, Count: 6}}},
+		{"Component", "component", 0, 0, []row{
+			{Values: []string{"c.Cache"}, Count: 3},
+			{Values: []string{"c.Store"}, Count: 3},
+		}},
+		{"ComponentLevel", "component, level", 0, 0, []row{
+			{Values: []string{"c.Cache", "error"}, Count: 3},
+			{Values: []string{"c.Store", "error"}, Count: 2},
+			{Values: []string{"c.Store", "info"}, Count: 1},
+		}},
+		{"Attr", "attrs.path", 0, 0, []row{
+			{Values: []string{""}, Count: 3},
+			{Values: []string{"/add"}, Count: 2},
+			{Values: []string{"/list"}, Count: 1},
+		}},
+		{"Top", "msg", 0, 1, []row{{Values: []string{"miss"}, Count: 3}}},
+		{"Bucket", "component", time.Minute, 0, []row{
+			{Bucket: start, Values: []string{"c.Store"}, Count: 2},
+			{Bucket: start, Values: []string{"c.Cache"}, Count: 1},
+			{Bucket: start.Add(time.Minute), Values: []string{"c.Cache"}, Count: 2},
+			{Bucket: start.Add(time.Minute), Values: []string{"c.Store"}, Count: 1},
+		}},
+		{"BucketTop", "component", time.Minute, 1, []row{
+			{Bucket: start, Values: []string{"c.Store"}, Count: 2},
+			{Bucket: start.Add(time.Minute), Values: []string{"c.Cache"}, Count: 2},
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			a, err := newAggregator(test.keys, test.bucket, test.top)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range entries {
+				a.add(e)
+			}
+			var got []row
+			for _, g := range a.groups() {
+				got = append(got, row{g.bucket, g.values, g.count})
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("groups (-want +got):\n%s", diff)
+			}
+
+			// Make sure the table can be written.
+			var b strings.Builder
+			a.write(&b)
+			if !strings.Contains(b.String(), "COUNT") {
+				t.Fatalf("table missing COUNT column:\n%s", b.String())
+			}
+		})
+	}
+}
+
+func TestAggregatorInvalid(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		keys   string
+		bucket time.Duration
+		top    int
+	}{
+		{"UnknownField", "color", 0, 0},
+		{"EmptyAttr", "attrs.", 0, 0},
+		{"NegativeBucket", "", -time.Minute, 0},
+		{"NegativeTop", "", 0, -1},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := newAggregator(test.keys, test.bucket, test.top); err == nil {
+				t.Fatal("unexpected success")
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"text/template"
 	"time"
@@ -37,9 +38,12 @@ type LogsSpec struct {
 	Source  func(context.Context) (logging.Source, error) // returns log source
 
 	// Flags.
-	follow bool
-	format string
-	system bool
+	follow  bool
+	format  string
+	system  bool
+	countBy string
+	bucket  time.Duration
+	top     int
 }
 
 // fullEntry is like runtime.LogEntry, but has all the fields present in the
@@ -58,6 +62,8 @@ type fullEntry struct {
 	File          string            `json:"file"`
 	Line          int32             `json:"line"`
 	Msg           string            `json:"msg"`
+	TraceID       string            `json:"trace_id,omitempty"`
+	SpanID        string            `json:"span_id,omitempty"`
 	Attrs         map[string]string `json:"attrs"`
 }
 
@@ -70,8 +76,12 @@ func LogsCmd(spec *LogsSpec) *Command {
 	spec.Flags.BoolVar(&spec.follow, "follow", false, "Act like tail -f")
 	spec.Flags.StringVar(&spec.format, "format", "pretty", "Output format (pretty or json)")
 	spec.Flags.BoolVar(&spec.system, "system", false, "Show system internal logs")
+	spec.Flags.StringVar(&spec.countBy, "count-by", "", "Count logs by a comma-separated list of fields and attributes (e.g., component,attrs.foo)")
+	spec.Flags.DurationVar(&spec.bucket, "bucket", 0, "Count logs per time bucket of this duration (e.g., 1m)")
+	spec.Flags.IntVar(&spec.top, "top", 0, "Show only the top N counts (per time bucket)")
 	const help = `Usage:
   {{.Tool}} logs [--follow] [--format=<format>] [--system] [query]
+  {{.Tool}} logs [--count-by=<keys>] [--bucket=<duration>] [--top=<n>] [--system] [query]
 
 Flags:
   -h, --help	Print this help message.
@@ -91,6 +101,8 @@ Queries:
       * level          : the level of the log (e.g., debug, info)
       * source         : the file:line from which the log entry was logged
       * msg            : the logged message
+      * trace_id       : the id of the trace in which the entry was logged
+      * span_id        : the id of the span in which the entry was logged
       * attrs          : the user provided attributes
 
   Queries are boolean expressions over these fields. For example, the query
@@ -131,6 +143,13 @@ Examples:
   # the following command.
   date --rfc-3339=s --date="3 hours ago" | tr ' ' 'T'
 
+  # Display all of the logs for the "todo" app that were logged in the last 15
+  # minutes. now is the time the query is run.
+  {{.Tool}} logs 'app=="todo" && time > now - duration("15m")'
+
+  # Display all of the logs that were logged between 2 and 1 hours ago.
+  {{.Tool}} logs 'time >= now - duration("2h") && time < now - duration("1h")'
+
   # Display all of the debug logs for the "todo" app.
   {{.Tool}} logs 'app=="todo" && level=="debug"'
 
@@ -151,6 +170,9 @@ Examples:
   # https://github.com/google/re2/wiki/Syntax for details.
   {{.Tool}} logs 'msg.matches("error: file .* already closed")'
 
+  # Display all of the logs whose message starts with "timeout".
+  {{.Tool}} logs 'msg.startsWith("timeout")'
+
   # Display all of the logs that have an attribute "foo" with value "bar". Note that
   # when you write attrs["foo"], there is an implicit check that the "foo"
   # attribute exists.
@@ -166,12 +188,25 @@ Examples:
   # same as the query !("foo" in attrs && attrs["foo"] == "bar").
   {{.Tool}} logs '!(attrs["foo"] == "bar")'
 
+  # Display all of the logs with an integer attribute "code" that is at least
+  # 500. int, double, and duration convert attributes to numbers and durations.
+  # Logs whose attribute can't be converted don't match.
+  {{.Tool}} logs 'int(attrs["code"]) >= 500'
+
+  # Display all of the logs with an attribute "latency", like "1.5s", that is
+  # longer than 100 milliseconds.
+  {{.Tool}} logs 'duration(attrs["latency"]) > duration("100ms")'
+
   # Display all of the logs that have a "foo" attribute.
   {{.Tool}} logs '"foo" in attrs'
 
   # Display all of the logs that don't have a "foo" attribute.
   {{.Tool}} logs '!("foo" in attrs)'
 
+  # Display all of the logs logged while serving the trace with the provided
+  # id. Trace ids are shown in the dashboard.
+  {{.Tool}} logs 'trace_id == "4bf92f3577b34da6a3ce929d0e0e4736"'
+
   # Display all of the logs in JSON format. This is useful if you want to
   # perform some sort of post-processing on the logs.
   {{.Tool}} logs --format=json
@@ -183,6 +218,22 @@ Examples:
   # Display all of the logs, but without color.
   NO_COLOR= {{.Tool}} logs
 
+Aggregation:
+  The --count-by, --bucket, and --top flags summarize the logs that match a
+  query, rather than displaying them. --count-by counts logs by a
+  comma-separated list of fields (e.g., component) and attributes (e.g.,
+  attrs.foo). --bucket counts logs per time bucket. --top shows only the
+  largest counts (per time bucket). Aggregation can't be used with --follow.
+
+  # Count the error logs of every component in every minute.
+  {{.Tool}} logs --count-by=component --bucket=1m 'level == "error"'
+
+  # Display the 10 most frequent log messages of the "todo" app.
+  {{.Tool}} logs --count-by=msg --top=10 'app == "todo"'
+
+  # Count the logs in the last hour by level and by attribute "path".
+  {{.Tool}} logs --count-by=level,attrs.path 'time > now - duration("1h")'
+
 Query Reference:
   Queries are written using a subset of the CEL language [1]. Thus, every
   syntactically valid query is also a syntactically valid CEL program.
@@ -199,15 +250,22 @@ Query Reference:
       * level: string
       * source: string
       * msg: string
+      * trace_id: string
+      * span_id: string
       * attrs: map[string]string
 
   A query is restricted to:
 
       * boolean algebra (!, &&, ||),
       * equalities and inequalities (==, !=, <, <=, >, >=),
-      * the string operations "contains" and "matches",
-      * map indexing (attrs["foo"]), and
-      * constant strings, timestamps, and ints.
+      * the string operations "contains", "matches", "startsWith", and
+        "endsWith",
+      * map indexing (attrs["foo"]),
+      * the conversions int, double, and duration of attributes (e.g.,
+        int(attrs["foo"])),
+      * constant strings, timestamps, durations, ints, and doubles, and
+      * the current time now, plus or minus durations (e.g.,
+        now - duration("15m")).
 
   Queries have the same semantics as CEL programs except for one small
   exception. An attribute expression like attrs["foo"] has an implicit
@@ -247,6 +305,17 @@ func (s *LogsSpec) logFn(ctx context.Context, args []string) error {
 	if s.format != "pretty" && s.format != "json" {
 		return fmt.Errorf("invalid format %q; must be %q or %q", s.format, "pretty", "json")
 	}
+	var agg *aggregator
+	if s.countBy != "" || s.bucket != 0 || s.top != 0 {
+		if s.follow {
+			return fmt.Errorf("--count-by, --bucket, and --top can't be used with --follow")
+		}
+		var err error
+		agg, err = newAggregator(s.countBy, s.bucket, s.top)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Rewrite the query, if needed.
 	if s.Rewrite != nil {
@@ -277,10 +346,17 @@ func (s *LogsSpec) logFn(ctx context.Context, args []string) error {
 	for {
 		entry, err := r.Read(ctx)
 		if errors.Is(err, io.EOF) {
+			if agg != nil {
+				agg.write(os.Stdout)
+			}
 			return nil
 		} else if err != nil {
 			return err
 		}
+		if agg != nil {
+			agg.add(entry)
+			continue
+		}
 		switch s.format {
 		case "pretty":
 			fmt.Println(pp.Format(entry))
@@ -307,6 +383,8 @@ func (s *LogsSpec) logFn(ctx context.Context, args []string) error {
 				File:          entry.File,
 				Line:          entry.Line,
 				Msg:           entry.Msg,
+				TraceID:       entry.TraceId,
+				SpanID:        entry.SpanId,
 				Attrs:         attrs,
 			}, "", "    ")
 			if err != nil {
@@ -27,8 +27,10 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
 	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"github.com/ServiceWeaver/weaver/runtime/retry"
 	"google.golang.org/protobuf/proto"
@@ -36,16 +38,29 @@ import (
 	sqlite3 "modernc.org/sqlite/lib"
 )
 
+// InstrumentationLibrary is the name of the instrumentation library of the
+// spans that Service Weaver creates for component method calls.
+const InstrumentationLibrary = "github.com/ServiceWeaver/weaver/serviceweaver"
+
 // DB is a trace database that stores traces on the local file system.
 type DB struct {
-	// Trace data is stored in a sqlite DB spread across two tables:
-	// (1) traces:         serialized trace data, used for querying.
-	// (2) encoded_spans:  full encoded span data, used for fetching all of the
-	//                     spans that belong to a given trace.
+	// Trace data is stored in a sqlite DB spread across three tables:
+	// (1) traces:          serialized trace data, used for querying.
+	// (2) encoded_spans:   full encoded span data, used for fetching all of the
+	//                      spans that belong to a given trace, along with the
+	//                      component method traced by every span.
+	// (3) span_attributes: span attributes, used for querying.
 	fname string
 	db    *sql.DB
 }
 
+// migrations upgrade the schema of an existing trace database. migrations[i]
+// upgrades the database from schema version i to schema version i+1. The
+// schema version of a database is stored in its user_version pragma.
+var migrations = []func(context.Context, *sql.Conn) error{
+	addSearchIndex,
+}
+
 // OpenDB opens the trace database persisted in the provided file. If the
 // file doesn't exist, this call creates it.
 func OpenDB(ctx context.Context, fname string) (*DB, error) {
@@ -111,10 +126,141 @@ END;
 	if _, err := t.execDB(ctx, initDB); err != nil {
 		return nil, fmt.Errorf("open trace DB %s: %w", fname, err)
 	}
+	if err := t.migrate(ctx); err != nil {
+		return nil, fmt.Errorf("migrate trace DB %s: %w", fname, err)
+	}
 
 	return t, nil
 }
 
+// migrate applies the migrations that haven't yet been applied to the
+// database.
+func (d *DB) migrate(ctx context.Context) error {
+	conn, err := d.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Lock the database for writing, so that concurrent openers of the
+	// database don't migrate it at the same time.
+	if err := retryLocked(ctx, func() error {
+		_, err := conn.ExecContext(ctx, "BEGIN IMMEDIATE")
+		return err
+	}); err != nil {
+		return err
+	}
+	commit := func() error {
+		var version int
+		if err := conn.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+			return err
+		}
+		if version >= len(migrations) {
+			return nil
+		}
+		for _, migration := range migrations[version:] {
+			if err := migration(ctx, conn); err != nil {
+				return err
+			}
+		}
+		_, err := conn.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version=%d", len(migrations)))
+		return err
+	}
+	if err := commit(); err != nil {
+		conn.ExecContext(ctx, "ROLLBACK")
+		return err
+	}
+	_, err = conn.ExecContext(ctx, "COMMIT")
+	return err
+}
+
+// addSearchIndex adds the columns and tables used to search for traces by
+// route, component method, and span attribute, and populates them for the
+// spans that are already stored in the database.
+func addSearchIndex(ctx context.Context, conn *sql.Conn) error {
+	const schema = `
+ALTER TABLE traces ADD COLUMN route TEXT;
+ALTER TABLE encoded_spans ADD COLUMN component TEXT;
+ALTER TABLE encoded_spans ADD COLUMN method TEXT;
+
+-- Queryable span attributes.
+CREATE TABLE span_attributes (
+	trace_id TEXT NOT NULL,
+	start_time_unix_us INTEGER,
+	key TEXT,
+	value TEXT,
+	FOREIGN KEY (trace_id) REFERENCES traces (trace_id)
+);
+
+CREATE INDEX traces_route ON traces (route);
+CREATE INDEX encoded_spans_trace_id ON encoded_spans (trace_id);
+CREATE INDEX encoded_spans_method ON encoded_spans (component, method);
+CREATE INDEX span_attributes_key_value ON span_attributes (key, value);
+CREATE INDEX span_attributes_start_time ON span_attributes (start_time_unix_us);
+
+-- Garbage-collect span attributes older than 30 days.
+CREATE TRIGGER expire_span_attributes AFTER INSERT ON span_attributes
+BEGIN
+	DELETE FROM span_attributes
+	WHERE start_time_unix_us < (1000000 * unixepoch('now', '-30 days'));
+END;
+`
+	if _, err := conn.ExecContext(ctx, schema); err != nil {
+		return err
+	}
+
+	// Index the existing spans, a batch at a time.
+	const batchSize = 1000
+	type row struct {
+		rowid int64
+		span  *protos.Span
+	}
+	for last := int64(0); ; {
+		rows, err := conn.QueryContext(ctx, `SELECT rowid, data FROM encoded_spans WHERE rowid>? ORDER BY rowid LIMIT ?`, last, batchSize)
+		if err != nil {
+			return err
+		}
+		var batch []row
+		for rows.Next() {
+			var r row
+			var encoded []byte
+			if err := rows.Scan(&r.rowid, &encoded); err != nil {
+				rows.Close()
+				return err
+			}
+			r.span = &protos.Span{}
+			if err := proto.Unmarshal(encoded, r.span); err != nil {
+				rows.Close()
+				return err
+			}
+			batch = append(batch, r)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		last = batch[len(batch)-1].rowid
+
+		for _, r := range batch {
+			component, method := spanMethod(r.span)
+			if _, err := conn.ExecContext(ctx, `UPDATE encoded_spans SET component=?, method=? WHERE rowid=?`, component, method, r.rowid); err != nil {
+				return err
+			}
+			if err := storeAttributes(ctx, conn, r.span); err != nil {
+				return err
+			}
+			if isRootSpan(r.span) {
+				if _, err := conn.ExecContext(ctx, `UPDATE traces SET route=? WHERE trace_id=?`, spanRoute(r.span), hex.EncodeToString(r.span.TraceId)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
 // Close closes the trace database.
 func (d *DB) Close() error {
 	return d.db.Close()
@@ -149,8 +295,8 @@ func (d *DB) Store(ctx context.Context, app, version string, spans *protos.Trace
 }
 
 func (d *DB) storeTrace(ctx context.Context, tx *sql.Tx, app, version string, root *protos.Span) error {
-	const traceStmt = `INSERT INTO traces VALUES (?,?,?,?,?,?,?)`
-	_, err := tx.ExecContext(ctx, traceStmt, hex.EncodeToString(root.TraceId), app, version, root.Name, root.StartMicros, root.EndMicros, spanStatus(root))
+	const traceStmt = `INSERT INTO traces (trace_id, app, version, name, start_time_unix_us, end_time_unix_us, status, route) VALUES (?,?,?,?,?,?,?,?)`
+	_, err := tx.ExecContext(ctx, traceStmt, hex.EncodeToString(root.TraceId), app, version, root.Name, root.StartMicros, root.EndMicros, spanStatus(root), spanRoute(root))
 	return err
 }
 
@@ -159,9 +305,30 @@ func (d *DB) storeSpan(ctx context.Context, tx *sql.Tx, span *protos.Span) error
 	if err != nil {
 		return err
 	}
-	const stmt = `INSERT INTO encoded_spans VALUES (?,?,?)`
-	_, err = tx.ExecContext(ctx, stmt, hex.EncodeToString(span.TraceId), span.StartMicros, encoded)
-	return err
+	component, method := spanMethod(span)
+	const stmt = `INSERT INTO encoded_spans (trace_id, start_time_unix_us, data, component, method) VALUES (?,?,?,?,?)`
+	if _, err = tx.ExecContext(ctx, stmt, hex.EncodeToString(span.TraceId), span.StartMicros, encoded, component, method); err != nil {
+		return err
+	}
+	return storeAttributes(ctx, tx, span)
+}
+
+// execer is implemented by *sql.Tx and *sql.Conn.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// storeAttributes stores the attributes of the provided span in the
+// span_attributes table.
+func storeAttributes(ctx context.Context, db execer, span *protos.Span) error {
+	const stmt = `INSERT INTO span_attributes VALUES (?,?,?,?)`
+	traceID := hex.EncodeToString(span.TraceId)
+	for _, attr := range fromProtoAttrs(span.Attributes) {
+		if _, err := db.ExecContext(ctx, stmt, traceID, span.StartMicros, string(attr.Key), attr.Value.Emit()); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // TraceSummary stores summary information about a trace.
@@ -169,6 +336,50 @@ type TraceSummary struct {
 	TraceID            string    // Unique trace identifier, in hex format.
 	StartTime, EndTime time.Time // Start and end times for the trace.
 	Status             string    // Trace status string.
+	App                string    // Application that generated the trace.
+	Version            string    // Application version that generated the trace.
+	Name               string    // Name of the trace's root span.
+	Route              string    // HTTP route of the trace's root span, if any.
+}
+
+// Query specifies the traces returned by Search. A trace matches the query if
+// it matches every field of the query. Fields that have a zero value
+// (e.g., empty App or Version, zero EndTime) are ignored, i.e., they match all
+// traces.
+type Query struct {
+	// App and Version match traces generated by the given application
+	// version. Version may be a prefix of the full version.
+	App, Version string
+
+	// StartTime and EndTime match traces that fit entirely in the
+	// [StartTime, EndTime] time interval.
+	StartTime, EndTime time.Time
+
+	// DurationLower and DurationUpper match traces whose duration is in the
+	// [DurationLower, DurationUpper) range.
+	DurationLower, DurationUpper time.Duration
+
+	// OnlyErrors matches traces that have an error status.
+	OnlyErrors bool
+
+	// Component and Method match traces that call the given method of the
+	// given component. Component is either a full component name (e.g.,
+	// "github.com/my/app/cart/Cart") or a suffix of one that starts after a
+	// slash (e.g., "cart/Cart").
+	Component, Method string
+
+	// AttributeKey and AttributeValue match traces that have a span with the
+	// given attribute. If AttributeValue is empty, AttributeKey matches
+	// traces with a span that has the attribute, regardless of its value.
+	AttributeKey, AttributeValue string
+
+	// Route matches traces started by HTTP requests to the given route
+	// (e.g., "/hello").
+	Route string
+
+	// Limit is the maximum number of traces to return. The most recent traces
+	// are returned.
+	Limit int64
 }
 
 // QueryTraces returns the summaries of the traces that match the given
@@ -181,43 +392,98 @@ type TraceSummary struct {
 //
 // Any query argument that has a zero value (e.g., empty app or version,
 // zero endTime) is ignored, i.e., it matches all spans.
+//
+// Unlike Search, QueryTraces only matches the full version. Use Search to
+// match traces by version prefix, component method, attribute, or route.
 func (d *DB) QueryTraces(ctx context.Context, app, version string, startTime, endTime time.Time, durationLower, durationUpper time.Duration, onlyErrors bool, limit int64) ([]TraceSummary, error) {
-	const query = `
-SELECT trace_id, start_time_unix_us, end_time_unix_us, status
+	return d.search(ctx, Query{
+		App:           app,
+		Version:       version,
+		StartTime:     startTime,
+		EndTime:       endTime,
+		DurationLower: durationLower,
+		DurationUpper: durationUpper,
+		OnlyErrors:    onlyErrors,
+		Limit:         limit,
+	}, true)
+}
+
+// Search returns the summaries of the traces that match the given query,
+// most recent first.
+func (d *DB) Search(ctx context.Context, q Query) ([]TraceSummary, error) {
+	return d.search(ctx, q, false)
+}
+
+// search returns the summaries of the traces that match the given query, most
+// recent first. If exactVersion is true, q.Version must match the full
+// version of a trace, rather than a prefix of it.
+func (d *DB) search(ctx context.Context, q Query, exactVersion bool) ([]TraceSummary, error) {
+	versionMatch := `substr(version, 1, length(?))=?`
+	if exactVersion {
+		versionMatch = `(version=? OR ?="")`
+	}
+	query := `
+SELECT trace_id, app, version, name, route, start_time_unix_us, end_time_unix_us, status
 FROM traces
 WHERE
-	(app=? OR ?="") AND (version=? OR ?="") AND
+	(app=? OR ?="") AND ` + versionMatch + ` AND
 	(start_time_unix_us>=? OR ?=0) AND (end_time_unix_us<=? OR ?=0) AND
 	((end_time_unix_us - start_time_unix_us)>=? OR ?=0) AND
 	((end_time_unix_us - start_time_unix_us)<? OR ?=0) AND
-	(status != "" OR ?=0)
+	(status != "" OR ?=0) AND
+	(route=? OR ?="") AND
+	((?="" AND ?="") OR EXISTS (
+		SELECT 1 FROM encoded_spans s
+		WHERE s.trace_id=traces.trace_id AND
+			(s.component=? OR substr(s.component, -length(?))=? OR ?="") AND
+			(s.method=? OR ?="")
+	)) AND
+	(?="" OR EXISTS (
+		SELECT 1 FROM span_attributes a
+		WHERE a.trace_id=traces.trace_id AND
+			a.key=? AND (a.value=? OR ?="")
+	))
 ORDER BY end_time_unix_us DESC
 LIMIT ?
 `
 	var startTimeUs int64
-	if !startTime.IsZero() {
-		startTimeUs = startTime.UnixMicro()
+	if !q.StartTime.IsZero() {
+		startTimeUs = q.StartTime.UnixMicro()
 	}
 	var endTimeUs int64
-	if !endTime.IsZero() {
-		endTimeUs = endTime.UnixMicro()
+	if !q.EndTime.IsZero() {
+		endTimeUs = q.EndTime.UnixMicro()
+	}
+	durationLowerUs := q.DurationLower.Microseconds()
+	durationUpperUs := q.DurationUpper.Microseconds()
+	var suffix string
+	if q.Component != "" {
+		suffix = "/" + q.Component
 	}
-	durationLowerUs := durationLower.Microseconds()
-	durationUpperUs := durationUpper.Microseconds()
+	limit := q.Limit
 	if limit <= 0 {
 		limit = math.MaxInt64
 	}
-	rows, err := d.queryDB(ctx, query, app, app, version, version, startTimeUs, startTimeUs, endTimeUs, endTimeUs, durationLowerUs, durationLowerUs, durationUpperUs, durationUpperUs, onlyErrors, limit)
+	rows, err := d.queryDB(ctx, query,
+		q.App, q.App, q.Version, q.Version,
+		startTimeUs, startTimeUs, endTimeUs, endTimeUs,
+		durationLowerUs, durationLowerUs, durationUpperUs, durationUpperUs,
+		q.OnlyErrors,
+		q.Route, q.Route,
+		q.Component, q.Method, q.Component, suffix, suffix, q.Component, q.Method, q.Method,
+		q.AttributeKey, q.AttributeKey, q.AttributeValue, q.AttributeValue,
+		limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	var traces []TraceSummary
 	for rows.Next() {
-		var traceID string
+		var traceID, app, version string
+		var name, route sql.NullString
 		var startTimeUs, endTimeUs int64
 		var status string
-		if err := rows.Scan(&traceID, &startTimeUs, &endTimeUs, &status); err != nil {
+		if err := rows.Scan(&traceID, &app, &version, &name, &route, &startTimeUs, &endTimeUs, &status); err != nil {
 			return nil, err
 		}
 		if status == "" {
@@ -228,6 +494,10 @@ LIMIT ?
 			StartTime: time.UnixMicro(startTimeUs),
 			EndTime:   time.UnixMicro(endTimeUs),
 			Status:    status,
+			App:       app,
+			Version:   version,
+			Name:      name.String,
+			Route:     route.String,
 		})
 	}
 	if err := rows.Err(); err != nil {
@@ -262,6 +532,16 @@ func (d *DB) FetchSpans(ctx context.Context, traceID string) ([]*protos.Span, er
 	return spans, nil
 }
 
+// retryLocked calls f until it doesn't return a "locked" error.
+func retryLocked(ctx context.Context, f func() error) error {
+	for r := retry.Begin(); r.Continue(ctx); {
+		if err := f(); !isLocked(err) {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
 func (d *DB) queryDB(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
 	// Keep retrying as long as we are getting the "locked" error.
 	for r := retry.Begin(); r.Continue(ctx); {
@@ -299,6 +579,56 @@ func isRootSpan(span *protos.Span) bool {
 	return bytes.Equal(span.ParentSpanId, nilSpanID[:])
 }
 
+// spanMethod returns the full component name and the method name of the
+// component method call traced by the provided span, or empty strings if the
+// span doesn't trace a component method call.
+func spanMethod(span *protos.Span) (component, method string) {
+	// Service Weaver names the spans of method calls
+	// <package name>.<interface>.<method> (e.g., "cart.Cart.Add"), which
+	// doesn't identify the component, so it records the full component name in
+	// a span attribute.
+	if span.GetScope().GetName() != InstrumentationLibrary && span.GetLibrary().GetName() != InstrumentationLibrary {
+		return "", ""
+	}
+	component = spanComponent(span)
+	i := strings.LastIndexByte(span.Name, '.')
+	if component == "" || i < 0 {
+		return "", ""
+	}
+	return component, span.Name[i+1:]
+}
+
+// spanComponent returns the full name of the component whose method is traced
+// by the provided span (e.g., "github.com/my/app/cart/Cart"), or "" if the span
+// doesn't trace a component method call.
+func spanComponent(span *protos.Span) string {
+	for _, attr := range span.Attributes {
+		if attr.Key == string(codegen.ComponentTraceKey) && attr.Value.Type == protos.Span_Attribute_Value_STRING {
+			return attr.Value.GetStr()
+		}
+	}
+	return ""
+}
+
+// spanRoute returns the route of the HTTP request handled by the provided
+// span, or "" if the span doesn't handle an HTTP request.
+func spanRoute(span *protos.Span) string {
+	var target string
+	for _, attr := range span.Attributes {
+		if attr.Value.Type != protos.Span_Attribute_Value_STRING {
+			continue
+		}
+		switch attr.Key {
+		case "http.route":
+			return attr.Value.GetStr()
+		case "http.target":
+			target = attr.Value.GetStr()
+		}
+	}
+	path, _, _ := strings.Cut(target, "?")
+	return path
+}
+
 // spanStatus returns the span status string. It returns "" if the status is OK.
 func spanStatus(span *protos.Span) string {
 	// Look for an error in the span status.
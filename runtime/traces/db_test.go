@@ -16,6 +16,7 @@ package traces_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/hex"
 	"fmt"
 	"path/filepath"
@@ -24,10 +25,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
 	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"github.com/ServiceWeaver/weaver/runtime/traces"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/proto"
 )
 
 // Current time, rounded to a whole number of microseconds.
@@ -44,7 +47,7 @@ func storeSpans(ctx context.Context, t *testing.T, db *traces.DB, app, version s
 func makeSpan(name string, tid, sid, pid string, start, end time.Time, attrs ...string) *protos.Span {
 	protoAttrs := make([]*protos.Span_Attribute, len(attrs))
 	for i, attr := range attrs {
-		parts := strings.Split(attr, "=")
+		parts := strings.SplitN(attr, "=", 2)
 		if len(parts) != 2 {
 			panic("attribute format not key=val")
 		}
@@ -120,97 +123,97 @@ func TestQueryTraces(t *testing.T) {
 
 	// Issue queries and verify that the results are as expected.
 	for _, tc := range []struct {
-		help               string
-		app                string
-		version            string
-		start, end         time.Time
-		durLower, durUpper time.Duration
-		onlyErrs           bool
-		limit              int64
-		expect             []traces.TraceSummary
+		help   string
+		query  traces.Query
+		expect []traces.TraceSummary
 	}{
 		{
 			help: "all",
 			expect: []traces.TraceSummary{
-				{tid(1), tick(3), tick(10), "OK"},
-				{tid(2), tick(1), tick(7), "OK"},
-				{tid(3), tick(2), tick(6), "Bad Request"},
+				{tid(1), tick(3), tick(10), "OK", "app1", "v1", "s1", ""},
+				{tid(2), tick(1), tick(7), "OK", "app1", "v2", "s4", ""},
+				{tid(3), tick(2), tick(6), "Bad Request", "app2", "v1", "s7", ""},
 			},
 		},
 		{
-			help: "match app",
-			app:  "app1",
+			help:  "match app",
+			query: traces.Query{App: "app1"},
 			expect: []traces.TraceSummary{
-				{tid(1), tick(3), tick(10), "OK"},
-				{tid(2), tick(1), tick(7), "OK"},
+				{tid(1), tick(3), tick(10), "OK", "app1", "v1", "s1", ""},
+				{tid(2), tick(1), tick(7), "OK", "app1", "v2", "s4", ""},
 			},
 		},
 		{
-			help:    "match version",
-			version: "v1",
+			help:  "match version",
+			query: traces.Query{Version: "v1"},
 			expect: []traces.TraceSummary{
-				{tid(1), tick(3), tick(10), "OK"},
-				{tid(3), tick(2), tick(6), "Bad Request"},
+				{tid(1), tick(3), tick(10), "OK", "app1", "v1", "s1", ""},
+				{tid(3), tick(2), tick(6), "Bad Request", "app2", "v1", "s7", ""},
 			},
 		},
 		{
-			help:    "match app version",
-			app:     "app1",
-			version: "v1",
+			help:  "match app version",
+			query: traces.Query{App: "app1", Version: "v1"},
 			expect: []traces.TraceSummary{
-				{tid(1), tick(3), tick(10), "OK"},
+				{tid(1), tick(3), tick(10), "OK", "app1", "v1", "s1", ""},
 			},
 		},
 		{
 			help:  "match start time",
-			start: tick(2),
+			query: traces.Query{StartTime: tick(2)},
 			expect: []traces.TraceSummary{
-				{tid(1), tick(3), tick(10), "OK"},
-				{tid(3), tick(2), tick(6), "Bad Request"},
+				{tid(1), tick(3), tick(10), "OK", "app1", "v1", "s1", ""},
+				{tid(3), tick(2), tick(6), "Bad Request", "app2", "v1", "s7", ""},
 			},
 		},
 		{
-			help: "match end time",
-			end:  tick(9),
+			help:  "match end time",
+			query: traces.Query{EndTime: tick(9)},
 			expect: []traces.TraceSummary{
-				{tid(2), tick(1), tick(7), "OK"},
-				{tid(3), tick(2), tick(6), "Bad Request"},
+				{tid(2), tick(1), tick(7), "OK", "app1", "v2", "s4", ""},
+				{tid(3), tick(2), tick(6), "Bad Request", "app2", "v1", "s7", ""},
 			},
 		},
 		{
-			help:     "match duration lower",
-			durLower: dur(5),
+			help:  "match duration lower",
+			query: traces.Query{DurationLower: dur(5)},
 			expect: []traces.TraceSummary{
-				{tid(1), tick(3), tick(10), "OK"},
-				{tid(2), tick(1), tick(7), "OK"},
+				{tid(1), tick(3), tick(10), "OK", "app1", "v1", "s1", ""},
+				{tid(2), tick(1), tick(7), "OK", "app1", "v2", "s4", ""},
 			},
 		},
 		{
-			help:     "match duration upper",
-			durUpper: dur(7),
+			help:  "match duration upper",
+			query: traces.Query{DurationUpper: dur(7)},
 			expect: []traces.TraceSummary{
-				{tid(2), tick(1), tick(7), "OK"},
-				{tid(3), tick(2), tick(6), "Bad Request"},
+				{tid(2), tick(1), tick(7), "OK", "app1", "v2", "s4", ""},
+				{tid(3), tick(2), tick(6), "Bad Request", "app2", "v1", "s7", ""},
 			},
 		},
 		{
-			help:     "match only errors",
-			onlyErrs: true,
+			help:  "match only errors",
+			query: traces.Query{OnlyErrors: true},
 			expect: []traces.TraceSummary{
-				{tid(3), tick(2), tick(6), "Bad Request"},
+				{tid(3), tick(2), tick(6), "Bad Request", "app2", "v1", "s7", ""},
 			},
 		},
 		{
 			help:  "match limit",
-			limit: 2,
+			query: traces.Query{Limit: 2},
 			expect: []traces.TraceSummary{
-				{tid(1), tick(3), tick(10), "OK"},
-				{tid(2), tick(1), tick(7), "OK"},
+				{tid(1), tick(3), tick(10), "OK", "app1", "v1", "s1", ""},
+				{tid(2), tick(1), tick(7), "OK", "app1", "v2", "s4", ""},
 			},
 		},
+		{
+			help:   "match full version only",
+			query:  traces.Query{Version: "v"},
+			expect: nil,
+		},
 	} {
 		t.Run(tc.help, func(t *testing.T) {
-			actual, err := db.QueryTraces(ctx, tc.app, tc.version, tc.start, tc.end, tc.durLower, tc.durUpper, tc.onlyErrs, tc.limit)
+			q := tc.query
+			actual, err := db.QueryTraces(ctx, q.App, q.Version, q.StartTime, q.EndTime, q.DurationLower, q.DurationUpper, q.OnlyErrors, q.Limit)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -232,6 +235,144 @@ func TestQueryTraces(t *testing.T) {
 	}
 }
 
+func TestSearchTraces(t *testing.T) {
+	ctx := context.Background()
+	fname := filepath.Join(t.TempDir(), "tracedb.db_test.db")
+	db, err := traces.OpenDB(ctx, fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// method returns a span that traces a call to a method of the
+	// github.com/shop/cart/Cart component, whose Go package is named main.
+	method := func(name string, tid, sid, pid string, start, end time.Time, attrs ...string) *protos.Span {
+		attrs = append(attrs, string(codegen.ComponentTraceKey)+"=github.com/shop/cart/Cart")
+		span := makeSpan(name, tid, sid, pid, start, end, attrs...)
+		span.Scope = &protos.Span_Scope{Name: traces.InstrumentationLibrary}
+		return span
+	}
+	storeSpans(ctx, t, db, "app", "v1",
+		makeSpan("hello", tid(1), sid(1), sid(0), tick(1), tick(5), "http.target=/hello?name=x"),
+		method("main.Cart.Add", tid(1), sid(2), sid(1), tick(2), tick(3), "user=alice"),
+		makeSpan("api", tid(2), sid(3), sid(0), tick(1), tick(5), "http.route=/users/{id}", "http.target=/users/42"),
+		method("main.Cart.Remove", tid(2), sid(4), sid(3), tick(2), tick(3), "user=bob"),
+		// Spans that aren't created by Service Weaver don't trace methods.
+		makeSpan("cart.Cart.Add", tid(2), sid(5), sid(3), tick(2), tick(3)),
+	)
+
+	for _, tc := range []struct {
+		help   string
+		query  traces.Query
+		expect []string // trace ids
+	}{
+		{"all", traces.Query{}, []string{tid(1), tid(2)}},
+		{"version prefix", traces.Query{Version: "v"}, []string{tid(1), tid(2)}},
+		{"component", traces.Query{Component: "github.com/shop/cart/Cart"}, []string{tid(1), tid(2)}},
+		{"component suffix", traces.Query{Component: "cart/Cart"}, []string{tid(1), tid(2)}},
+		{"partial component suffix", traces.Query{Component: "art/Cart"}, nil},
+		{"package name", traces.Query{Component: "main.Cart"}, nil},
+		{"method", traces.Query{Component: "cart/Cart", Method: "Add"}, []string{tid(1)}},
+		{"attribute key", traces.Query{AttributeKey: "user"}, []string{tid(1), tid(2)}},
+		{"attribute", traces.Query{AttributeKey: "user", AttributeValue: "bob"}, []string{tid(2)}},
+		{"unknown attribute", traces.Query{AttributeKey: "user", AttributeValue: "carol"}, nil},
+		{"target route", traces.Query{Route: "/hello"}, []string{tid(1)}},
+		{"http route", traces.Query{Route: "/users/{id}"}, []string{tid(2)}},
+	} {
+		t.Run(tc.help, func(t *testing.T) {
+			actual, err := db.Search(ctx, tc.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, trace := range actual {
+				id, err := hex.DecodeString(trace.TraceID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				ids = append(ids, string(id))
+			}
+			if diff := cmp.Diff(tc.expect, ids, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("unexpected traces: (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	// Create a database with the original schema, without the search index.
+	ctx := context.Background()
+	fname := filepath.Join(t.TempDir(), "tracedb.db_test.db")
+	old, err := sql.Open("sqlite", fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const schema = `
+CREATE TABLE traces (
+	trace_id TEXT NOT NULL,
+	app TEXT NOT NULL,
+	version TEXT NOT NULL,
+	name TEXT,
+	start_time_unix_us INTEGER,
+	end_time_unix_us INTEGER,
+	status TEXT,
+	PRIMARY KEY(trace_id)
+);
+CREATE TABLE encoded_spans (
+	trace_id TEXT NOT NULL,
+	start_time_unix_us INTEGER,
+	data TEXT,
+	FOREIGN KEY (trace_id) REFERENCES traces (trace_id)
+);
+`
+	if _, err := old.ExecContext(ctx, schema); err != nil {
+		t.Fatal(err)
+	}
+	root := makeSpan("hello", tid(1), sid(1), sid(0), now, now.Add(time.Second), "http.target=/hello")
+	child := makeSpan("main.Cart.Add", tid(1), sid(2), sid(1), now, now.Add(time.Second), "user=alice", string(codegen.ComponentTraceKey)+"=github.com/shop/cart/Cart")
+	child.Scope = &protos.Span_Scope{Name: traces.InstrumentationLibrary}
+	traceID := hex.EncodeToString(root.TraceId)
+	if _, err := old.ExecContext(ctx, `INSERT INTO traces VALUES (?,?,?,?,?,?,?)`, traceID, "app", "v1", root.Name, root.StartMicros, root.EndMicros, ""); err != nil {
+		t.Fatal(err)
+	}
+	for _, span := range []*protos.Span{root, child} {
+		encoded, err := proto.Marshal(span)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := old.ExecContext(ctx, `INSERT INTO encoded_spans VALUES (?,?,?)`, traceID, span.StartMicros, encoded); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := old.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Open the database, which migrates it, twice. Existing traces should be
+	// searchable.
+	for i := 0; i < 2; i++ {
+		db, err := traces.OpenDB(ctx, fname)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := db.Search(ctx, traces.Query{
+			Component:    "github.com/shop/cart/Cart",
+			Method:       "Add",
+			AttributeKey: "user",
+			Route:        "/hello",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(actual) != 1 || actual[0].TraceID != traceID {
+			t.Fatalf("Search: got %v, want trace %s", actual, traceID)
+		}
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
 func BenchmarkStore(b *testing.B) {
 	ctx := context.Background()
 	s := makeSpan("s1", tid(1), sid(1), sid(1), tick(3), tick(10))
@@ -0,0 +1,220 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"context"
+	"encoding/binary"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+const (
+	// defaultTailWindow is the default time for which a TailSampler buffers
+	// the spans of a trace.
+	defaultTailWindow = 10 * time.Second
+
+	// maxTailSpans is the maximum number of spans a TailSampler buffers.
+	// When the limit is exceeded, decisions are made early for the oldest
+	// traces.
+	maxTailSpans = 100000
+)
+
+// A TailSampler buffers the spans of every trace for a window of time, and
+// then decides whether to keep the trace based on all of its buffered spans,
+// as specified by a protos.TailSampling policy. Kept traces are passed to a
+// store function (e.g., DB.Store); dropped traces are discarded.
+//
+// Spans of a trace that arrive after the decision for the trace was made
+// follow the decision, as long as they arrive within a window of the
+// decision.
+type TailSampler struct {
+	policy     *protos.TailSampling
+	window     time.Duration
+	components map[string]bool // policy.Components
+	store      func(context.Context, *protos.TraceSpans) error
+	logger     *slog.Logger
+
+	mu      sync.Mutex
+	pending map[traceID]*pendingTrace // undecided traces, by id
+	order   []*pendingTrace           // undecided traces, oldest first
+	decided map[traceID]decision      // recent decisions, by trace id
+	n       int                       // number of buffered spans
+}
+
+// traceID is a trace id.
+type traceID [16]byte
+
+// pendingTrace is a trace whose spans are buffered.
+type pendingTrace struct {
+	id    traceID
+	first time.Time // when the first span arrived
+	spans []*protos.Span
+}
+
+// decision is the decision to keep or drop a trace.
+type decision struct {
+	keep bool
+	at   time.Time // when the decision was made
+}
+
+// NewTailSampler returns a TailSampler that implements the provided policy and
+// passes kept traces to store. Errors returned by store are logged to logger.
+// Call Run to periodically make decisions for buffered traces.
+func NewTailSampler(policy *protos.TailSampling, store func(context.Context, *protos.TraceSpans) error, logger *slog.Logger) *TailSampler {
+	window := time.Duration(policy.WindowNanos)
+	if window <= 0 {
+		window = defaultTailWindow
+	}
+	components := map[string]bool{}
+	for _, component := range policy.Components {
+		components[component] = true
+	}
+	return &TailSampler{
+		policy:     policy,
+		window:     window,
+		components: components,
+		store:      store,
+		logger:     logger,
+		pending:    map[traceID]*pendingTrace{},
+		decided:    map[traceID]decision{},
+	}
+}
+
+// Add buffers the provided spans. Spans of traces that have already been kept
+// are stored right away.
+func (t *TailSampler) Add(ctx context.Context, spans *protos.TraceSpans) error {
+	now := time.Now()
+	var kept []*protos.Span
+	t.mu.Lock()
+	for _, span := range spans.Span {
+		var id traceID
+		copy(id[:], span.TraceId)
+		if d, ok := t.decided[id]; ok {
+			if d.keep {
+				kept = append(kept, span)
+			}
+			continue
+		}
+		p, ok := t.pending[id]
+		if !ok {
+			p = &pendingTrace{id: id, first: now}
+			t.pending[id] = p
+			t.order = append(t.order, p)
+		}
+		p.spans = append(p.spans, span)
+		t.n++
+	}
+	// Make decisions early if too many spans are buffered.
+	for t.n > maxTailSpans && len(t.order) > 0 {
+		kept = append(kept, t.decideOldest(now)...)
+	}
+	t.mu.Unlock()
+
+	if len(kept) == 0 {
+		return nil
+	}
+	return t.store(ctx, &protos.TraceSpans{Span: kept})
+}
+
+// Run periodically makes decisions for the traces whose spans have been
+// buffered for a window, until ctx is cancelled. When ctx is cancelled, Run
+// makes decisions for all remaining buffered traces before returning.
+func (t *TailSampler) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.window / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			t.flush(context.WithoutCancel(ctx), time.Time{})
+			return
+		case now := <-ticker.C:
+			t.flush(ctx, now)
+		}
+	}
+}
+
+// flush makes decisions for the traces whose spans have been buffered since
+// before now minus the window, or for all traces if now is zero, and stores
+// the kept traces.
+func (t *TailSampler) flush(ctx context.Context, now time.Time) {
+	t.mu.Lock()
+	var kept []*protos.Span
+	for len(t.order) > 0 && (now.IsZero() || now.Sub(t.order[0].first) >= t.window) {
+		kept = append(kept, t.decideOldest(now)...)
+	}
+	for id, d := range t.decided {
+		if now.Sub(d.at) >= t.window {
+			delete(t.decided, id)
+		}
+	}
+	t.mu.Unlock()
+
+	if len(kept) == 0 {
+		return
+	}
+	if err := t.store(ctx, &protos.TraceSpans{Span: kept}); err != nil {
+		t.logger.Error("Cannot store traces", "err", err)
+	}
+}
+
+// decideOldest makes a decision for the oldest buffered trace and returns its
+// spans if the trace is kept.
+//
+// REQUIRES: t.mu is held and t.order is not empty.
+func (t *TailSampler) decideOldest(now time.Time) []*protos.Span {
+	p := t.order[0]
+	t.order = t.order[1:]
+	delete(t.pending, p.id)
+	t.n -= len(p.spans)
+	keep := t.keep(p)
+	t.decided[p.id] = decision{keep: keep, at: now}
+	if !keep {
+		return nil
+	}
+	return p.spans
+}
+
+// keep returns whether to keep the provided trace.
+func (t *TailSampler) keep(p *pendingTrace) bool {
+	start, end := int64(math.MaxInt64), int64(math.MinInt64)
+	for _, span := range p.spans {
+		if t.policy.Errors && spanStatus(span) != "" {
+			return true
+		}
+		if t.components[spanComponent(span)] {
+			return true
+		}
+		start = min(start, span.StartMicros)
+		end = max(end, span.EndMicros)
+	}
+	latency := time.Duration(end-start) * time.Microsecond
+	if t.policy.LatencyNanos > 0 && latency >= time.Duration(t.policy.LatencyNanos) {
+		return true
+	}
+
+	// Keep a ratio of the remaining traces, as determined by the trace id.
+	// The same trace id is always kept or dropped, even by different
+	// deployers.
+	if t.policy.Ratio >= 1 {
+		return true
+	}
+	x := binary.BigEndian.Uint64(p.id[8:16]) >> 1
+	return x < uint64(t.policy.Ratio*(1<<63))
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+	"github.com/ServiceWeaver/weaver/runtime/traces"
+	"github.com/google/go-cmp/cmp"
+)
+
+// tailSample passes the provided spans through a TailSampler with the
+// provided policy and returns the names of the kept spans.
+func tailSample(t *testing.T, policy *protos.TailSampling, spans ...*protos.Span) []string {
+	t.Helper()
+	var kept []string
+	store := func(_ context.Context, spans *protos.TraceSpans) error {
+		for _, span := range spans.Span {
+			kept = append(kept, span.Name)
+		}
+		return nil
+	}
+	sampler := traces.NewTailSampler(policy, store, slog.Default())
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := sampler.Add(ctx, &protos.TraceSpans{Span: spans}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Cancelling the context makes Run decide on all buffered traces.
+	cancel()
+	sampler.Run(ctx)
+	sort.Strings(kept)
+	return kept
+}
+
+func TestTailSampler(t *testing.T) {
+	errorSpan := func(name string, tid, sid, pid string, start, end time.Time) *protos.Span {
+		span := makeSpan(name, tid, sid, pid, start, end)
+		span.Status = &protos.Span_Status{Code: protos.Span_Status_ERROR, Error: "oops"}
+		return span
+	}
+	componentSpan := func(name, component string, tid, sid, pid string, start, end time.Time) *protos.Span {
+		span := makeSpan(name, tid, sid, pid, start, end)
+		span.Attributes = []*protos.Span_Attribute{{
+			Key: string(codegen.ComponentTraceKey),
+			Value: &protos.Span_Attribute_Value{
+				Type:  protos.Span_Attribute_Value_STRING,
+				Value: &protos.Span_Attribute_Value_Str{Str: component},
+			},
+		}}
+		return span
+	}
+	spans := []*protos.Span{
+		// Trace 1 is fast and has an error.
+		makeSpan("1.root", tid(1), sid(1), sid(0), tick(1), tick(2)),
+		errorSpan("1.child", tid(1), sid(2), sid(1), tick(1), tick(2)),
+		// Trace 2 is slow.
+		makeSpan("2.root", tid(2), sid(3), sid(0), tick(1), tick(5)),
+		makeSpan("2.child", tid(2), sid(4), sid(3), tick(4), tick(5)),
+		// Trace 3 is fast and calls component github.com/shop/cart/Cart,
+		// whose Go package is named main.
+		makeSpan("3.root", tid(3), sid(5), sid(0), tick(1), tick(2)),
+		componentSpan("main.Cart.Add", "github.com/shop/cart/Cart", tid(3), sid(6), sid(5), tick(1), tick(2)),
+		// Trace 4 is fast and calls another component named Cart.
+		makeSpan("4.root", tid(4), sid(7), sid(0), tick(1), tick(2)),
+		componentSpan("cart.Cart.Add", "github.com/other/cart/Cart", tid(4), sid(8), sid(7), tick(1), tick(2)),
+	}
+
+	for _, test := range []struct {
+		name   string
+		policy *protos.TailSampling
+		want   []string
+	}{
+		{
+			name:   "errors",
+			policy: &protos.TailSampling{Errors: true},
+			want:   []string{"1.child", "1.root"},
+		},
+		{
+			name:   "latency",
+			policy: &protos.TailSampling{LatencyNanos: int64(3 * time.Second)},
+			want:   []string{"2.child", "2.root"},
+		},
+		{
+			name:   "components",
+			policy: &protos.TailSampling{Components: []string{"github.com/shop/cart/Cart"}},
+			want:   []string{"3.root", "main.Cart.Add"},
+		},
+		{
+			name:   "all",
+			policy: &protos.TailSampling{Ratio: 1},
+			want:   []string{"1.child", "1.root", "2.child", "2.root", "3.root", "4.root", "cart.Cart.Add", "main.Cart.Add"},
+		},
+		{
+			name:   "none",
+			policy: &protos.TailSampling{Ratio: 0, LatencyNanos: int64(time.Hour)},
+			want:   nil,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got := tailSample(t, test.policy, spans...)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Fatalf("kept spans (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTailSamplerLateSpans(t *testing.T) {
+	var kept []string
+	store := func(_ context.Context, spans *protos.TraceSpans) error {
+		for _, span := range spans.Span {
+			kept = append(kept, span.Name)
+		}
+		return nil
+	}
+	sampler := traces.NewTailSampler(&protos.TailSampling{Errors: true}, store, slog.Default())
+	ctx, cancel := context.WithCancel(context.Background())
+	root := makeSpan("1.root", tid(1), sid(1), sid(0), tick(1), tick(2))
+	root.Status = &protos.Span_Status{Code: protos.Span_Status_ERROR}
+	if err := sampler.Add(ctx, &protos.TraceSpans{Span: []*protos.Span{
+		root,
+		makeSpan("2.root", tid(2), sid(2), sid(0), tick(1), tick(2)),
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	sampler.Run(ctx)
+
+	// Spans that arrive after a decision follow the decision.
+	if err := sampler.Add(ctx, &protos.TraceSpans{Span: []*protos.Span{
+		makeSpan("1.late", tid(1), sid(3), sid(1), tick(1), tick(2)),
+		makeSpan("2.late", tid(2), sid(4), sid(2), tick(1), tick(2)),
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"1.root", "1.late"}, kept); diff != "" {
+		t.Fatalf("kept spans (-want +got):\n%s", diff)
+	}
+}
+
+func TestTailSamplerRatio(t *testing.T) {
+	// The same trace ids are kept by different samplers.
+	var spans []*protos.Span
+	for i := 0; i < 1000; i++ {
+		id := make([]byte, 16)
+		id[8], id[9] = byte(i), byte(i>>8)
+		spans = append(spans, makeSpan(fmt.Sprint(i), string(id), sid(i+1), sid(0), tick(1), tick(2)))
+	}
+	policy := &protos.TailSampling{Ratio: 0.5}
+	first := tailSample(t, policy, spans...)
+	second := tailSample(t, policy, spans...)
+	if len(first) == 0 || len(first) == len(spans) {
+		t.Fatalf("got %d kept traces out of %d", len(first), len(spans))
+	}
+	if diff := cmp.Diff(first, second); diff != "" {
+		t.Fatalf("kept spans (-first +second):\n%s", diff)
+	}
+}
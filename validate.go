@@ -70,6 +70,13 @@ func validateRegistrations(regs []*codegen.Registration) error {
 					err := fmt.Errorf("component implementation struct %v has a listener field %v, but listener %v hasn't been registered; maybe you forgot to run 'weaver generate'", reg.Impl, name, name)
 					errs = append(errs, err)
 				}
+
+			case f.Anonymous && f.Type == reflection.Type[Singleton]():
+				// f is an embedded weaver.Singleton.
+				if !reg.Singleton {
+					err := fmt.Errorf("component implementation struct %v embeds weaver.Singleton, but the component wasn't registered as a singleton; maybe you forgot to run 'weaver generate'", reg.Impl)
+					errs = append(errs, err)
+				}
 			}
 		}
 	}
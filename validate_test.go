@@ -149,3 +149,30 @@ func TestValidateUnregisteredListener(t *testing.T) {
 		}
 	}
 }
+
+// TestValidateUnregisteredSingleton tests that validateRegistrations fails
+// when a component embeds weaver.Singleton but isn't registered as a singleton.
+func TestValidateUnregisteredSingleton(t *testing.T) {
+	type foo interface{}
+	type fooImpl struct{ Singleton }
+	regs := []*codegen.Registration{
+		{
+			Name:  "foo",
+			Iface: reflection.Type[foo](),
+			Impl:  reflection.Type[fooImpl](),
+		},
+	}
+	err := validateRegistrations(regs)
+	if err == nil {
+		t.Fatal("unexpected validateRegistrations success")
+	}
+	const want = "wasn't registered as a singleton"
+	if !strings.Contains(err.Error(), want) {
+		t.Fatalf("validateRegistrations: got %q, want %q", err, want)
+	}
+
+	regs[0].Singleton = true
+	if err := validateRegistrations(regs); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"net"
 	"net/http"
 	"os"
@@ -38,6 +39,7 @@ import (
 	"github.com/ServiceWeaver/weaver/internal/weaver"
 	"github.com/ServiceWeaver/weaver/runtime"
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/logging"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -188,6 +190,16 @@ func runRemote[T any, _ PointerToMain[T]](ctx context.Context, app func(context.
 	}
 
 	opts := weaver.RemoteWeaveletOptions{}
+	if dir := os.Getenv(weaver.RecordCallsEnvKey); dir != "" {
+		// Record the remote calls made by this weavelet. Writes are not
+		// buffered, so the file doesn't need to be flushed on exit.
+		f, err := os.CreateTemp(dir, "*.calls")
+		if err != nil {
+			return fmt.Errorf("record calls: %w", err)
+		}
+		defer f.Close()
+		opts.RecordCalls = weaver.NewCallRecorder(f)
+	}
 	wlet, err := weaver.NewRemoteWeavelet(ctx, regs, bootstrap, opts)
 	if err != nil {
 		return err
@@ -253,8 +265,13 @@ type Implements[T any] struct {
 // Logger returns a logger that associates its log entries with this component.
 // Log entries are labeled with any OpenTelemetry trace id and span id in the
 // provided context.
+//
+// The trace and span ids are also recorded in the "traceid" and "spanid"
+// attributes of every log entry. These attributes are deprecated and will be
+// removed in the next release. Query the trace_id and span_id fields of a log
+// entry instead.
 func (i Implements[T]) Logger(ctx context.Context) *slog.Logger {
-	logger := i.logger
+	logger := logging.WithSpan(ctx, i.logger)
 	s := trace.SpanContextFromContext(ctx)
 	if s.HasTraceID() {
 		logger = logger.With("traceid", s.TraceID().String())
@@ -523,6 +540,171 @@ type Unrouted interface {
 
 var _ Unrouted = (*implementsImpl)(nil)
 
+// Singleton is a type that can be embedded inside a component implementation
+// struct to indicate that exactly one replica of the component should be
+// active at a time. For example, consider a component that periodically
+// reconciles a ledger:
+//
+//	type reconciler struct {
+//	    weaver.Implements[Reconciler]
+//	    weaver.Singleton
+//	}
+//
+// Like any other component, a singleton component may be replicated, but the
+// deployer elects one of the replicas as the component's leader. All method
+// calls to the component are sent to the leader, and if the leader fails, the
+// deployer elects a new leader among the remaining replicas. Every replica is
+// still created and initialized, so work that only the leader should do, like
+// the periodic reconciliation above, should be guarded by [Singleton.Lead]:
+//
+//	func (r *reconciler) Init(ctx context.Context) error {
+//	    go func() {
+//	        for {
+//	            lctx, cancel, err := r.Lead(ctx) // blocks until r is the leader
+//	            if err != nil {
+//	                return
+//	            }
+//	            r.reconcile(lctx) // runs until r stops being the leader
+//	            cancel()
+//	        }
+//	    }()
+//	    return nil
+//	}
+//
+// A singleton component cannot be routed.
+//
+// # Semantics
+//
+// Leadership is coordinated by the deployer on a best-effort basis. When a
+// leader fails, the new leader may be elected before the old leader notices
+// that it has failed, so two replicas may briefly believe they are the leader.
+// Don't rely on leadership for correctness when the work guarded by it isn't
+// idempotent.
+type Singleton struct {
+	leadership *weaver.Leadership
+}
+
+// IsLeader returns whether this replica of the component is currently the
+// component's leader.
+func (s *Singleton) IsLeader() bool {
+	if s.leadership == nil {
+		return false
+	}
+	return s.leadership.IsLeader()
+}
+
+// Lead blocks until this replica of the component is the component's leader.
+// It returns a context that is cancelled when the replica stops being the
+// leader or when ctx is cancelled. Like [context.WithCancel], Lead also returns
+// a cancel function that must be called once the returned context is no longer
+// needed, to release its resources. Lead returns an error if ctx is cancelled
+// before the replica becomes the leader.
+func (s *Singleton) Lead(ctx context.Context) (context.Context, context.CancelFunc, error) {
+	if s.leadership == nil {
+		<-ctx.Done()
+		return nil, nil, ctx.Err()
+	}
+	return s.leadership.Lead(ctx)
+}
+
+// setLeadership sets the leadership of the component replica.
+func (s *Singleton) setLeadership(leadership *weaver.Leadership) {
+	s.leadership = leadership
+}
+
+// OwnershipObserver is an interface that can be implemented by a routed
+// component implementation to be notified when the replica gains or loses
+// ownership of routing keys. For example, a routed cache can drop the entries
+// it no longer owns:
+//
+//	type cache struct {
+//	    weaver.Implements[Cache]
+//	    weaver.WithRouter[cacheRouter]
+//	    mu      sync.Mutex
+//	    entries map[string]string
+//	}
+//
+//	func (c *cache) OwnershipChanged(_ context.Context, change weaver.OwnershipChange) {
+//	    c.mu.Lock()
+//	    defer c.mu.Unlock()
+//	    for key := range c.entries {
+//	        if change.ReleasedKey(key) {
+//	            delete(c.entries, key)
+//	        }
+//	    }
+//	}
+//
+// OwnershipChanged is called after the component is initialized, once for
+// every change in the keys owned by the replica, in the order the changes
+// occur. Calls are never concurrent, but they are concurrent with method
+// calls. Like routing itself, ownership is best-effort: the replica may
+// still receive calls for keys it has released or not yet acquired.
+type OwnershipObserver interface {
+	OwnershipChanged(context.Context, OwnershipChange)
+}
+
+// KeyRange is the range [Start, End) of shard keys. Every routing key is
+// mapped to a shard key, and a replica of a routed component owns a set of
+// key ranges. Calls are routed to the replica that owns their shard key.
+//
+// The last key range of the shard key space ends at math.MaxUint64, which is
+// itself a valid shard key. A key range whose End is math.MaxUint64 is
+// therefore the range [Start, End], and it contains math.MaxUint64.
+type KeyRange struct {
+	Start uint64 // inclusive
+	End   uint64 // exclusive, unless math.MaxUint64
+}
+
+// Contains returns whether the key range contains the provided shard key.
+func (r KeyRange) Contains(shardKey uint64) bool {
+	if r.End == math.MaxUint64 {
+		return r.Start <= shardKey
+	}
+	return r.Start <= shardKey && shardKey < r.End
+}
+
+// OwnershipChange is a change in the key ranges owned by a replica of a
+// routed component. See [OwnershipObserver] for details.
+type OwnershipChange struct {
+	Version  uint64     // version of the routing assignment
+	Acquired []KeyRange // key ranges the replica now owns
+	Released []KeyRange // key ranges the replica no longer owns
+
+	shardKey func(key any) uint64
+}
+
+// ShardKey returns the shard key of the provided routing key. The routing key
+// must have the type returned by the component's router methods, or ShardKey
+// panics.
+func (c OwnershipChange) ShardKey(key any) uint64 {
+	return c.shardKey(key)
+}
+
+// AcquiredKey returns whether the replica acquired the provided routing key.
+// The routing key must have the type returned by the component's router
+// methods, or AcquiredKey panics.
+func (c OwnershipChange) AcquiredKey(key any) bool {
+	return containsKey(c.Acquired, c.ShardKey(key))
+}
+
+// ReleasedKey returns whether the replica released the provided routing key.
+// The routing key must have the type returned by the component's router
+// methods, or ReleasedKey panics.
+func (c OwnershipChange) ReleasedKey(key any) bool {
+	return containsKey(c.Released, c.ShardKey(key))
+}
+
+// containsKey returns whether any of the provided key ranges contains the
+// provided shard key.
+func containsKey(ranges []KeyRange, shardKey uint64) bool {
+	for _, r := range ranges {
+		if r.Contains(shardKey) {
+			return true
+		}
+	}
+	return false
+}
+
 // AutoMarshal is a type that can be embedded within a struct to indicate that
 // "weaver generate" should generate serialization methods for the struct.
 //
@@ -537,6 +719,28 @@ var _ Unrouted = (*implementsImpl)(nil)
 // The AutoMarshal embedding instructs "weaver generate" to generate
 // serialization methods for the struct, Pair in this example.
 //
+// By default, the fields of the struct are encoded in order, so adding,
+// removing, or reordering fields changes the encoding, and every component
+// that sends or receives the struct must be redeployed together. To evolve a
+// struct across versions of an application instead, give every field a unique
+// positive numeric tag:
+//
+//	type Pair struct {
+//	    weaver.AutoMarshal
+//	    x int `weaver:"1"`
+//	    y int `weaver:"2"`
+//	}
+//
+// Tagged fields are encoded along with their tags. When decoding, fields with
+// unknown tags are skipped, and missing fields are left zero, so fields can be
+// added and removed as long as tags are never reused. Tagged encoding is
+// slightly larger and slower than the default encoding.
+//
+// Values of a named interface type can be sent and received if their concrete
+// types are structs (or pointers to structs) that embed AutoMarshal. "weaver
+// generate" registers such structs automatically when they implement a named
+// interface type that is serialized in the same package.
+//
 // Note, however, that AutoMarshal cannot magically make any type serializable.
 // For example, "weaver generate" will raise an error for the following code
 // because the NotSerializable struct is fundamentally not serializable.
@@ -557,3 +761,36 @@ func (AutoMarshal) WeaverMarshal(*codegen.Encoder)   {}
 func (AutoMarshal) WeaverUnmarshal(*codegen.Decoder) {}
 
 type NotRetriable interface{}
+
+// Gateway is a marker that instructs "weaver generate" to generate an
+// HTTP/JSON gateway for a component. To generate a gateway for component Foo,
+// declare the following in the package that implements Foo:
+//
+//	var _ weaver.Gateway = Foo(nil)
+//
+// "weaver generate" then generates a NewFooGateway function (or
+// newFooGateway, if Foo is unexported) that returns an http.Handler serving
+// every method of the component at "POST /Foo/<Method>". The request body is
+// a JSON array of the method's arguments, excluding the context, and the
+// response body is a JSON array of the method's results, excluding the error.
+// Arguments and results are marshaled with the encoding/json package, so only
+// their exported fields are sent. If the method returns an error, the response
+// has status 500 and a body of the form {"error": "<message>"}. An OpenAPI
+// description of the gateway is served at "GET /Foo/openapi.json".
+//
+// "weaver generate" reports an error if a method of Foo can't be served as
+// JSON, e.g., if an argument has an interface type, or if an argument or
+// result is a struct with no exported fields.
+//
+// Serve the gateway on a listener to expose the component to non-Go clients:
+//
+//	type app struct {
+//	    weaver.Implements[weaver.Main]
+//	    foo weaver.Ref[Foo]
+//	    api weaver.Listener
+//	}
+//
+//	func (a *app) Main(ctx context.Context) error {
+//	    return http.Serve(a.api, NewFooGateway(a.foo.Get()))
+//	}
+type Gateway interface{}
@@ -25,6 +25,7 @@ import (
 	"github.com/ServiceWeaver/weaver/internal/envelope/conn"
 	"github.com/ServiceWeaver/weaver/runtime"
 	"github.com/ServiceWeaver/weaver/runtime/envelope"
+	"github.com/ServiceWeaver/weaver/runtime/metrics"
 	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"github.com/google/uuid"
 	"golang.org/x/exp/maps"
@@ -43,8 +44,8 @@ const DefaultReplication = 2
 //
 // This deployer differs from 'weaver multi' in two key ways.
 //
-//  1. This deployer doesn't implement unneeded features (e.g., traces,
-//     metrics, routing, health checking). This greatly simplifies the
+//  1. This deployer doesn't implement unneeded features (e.g., trace storage,
+//     metrics export, routing, health checking). This greatly simplifies the
 //     implementation.
 //  2. This deployer handles the fact that the main component is run in the
 //     same process as the deployer. This is special to weavertests and
@@ -59,6 +60,7 @@ type deployer struct {
 	running    errgroup.Group         // collects errors from goroutines
 	local      map[string]bool        // Components that should run locally
 	log        func(*protos.LogEntry) // logs the passed in string
+	telemetry  *Telemetry             // receives traces
 
 	mu     sync.Mutex        // guards fields below
 	groups map[string]*group // groups, by group name
@@ -72,6 +74,8 @@ type group struct {
 	components  map[string]bool         // started components
 	addresses   map[string]bool         // weavelet addresses
 	subscribers map[string][]connection // routing info subscribers, by component
+	singletons  map[string]bool         // started singleton components
+	leader      string                  // address of the first weavelet, the leader of all singletons
 }
 
 // handler handles a connection to a weavelet.
@@ -104,7 +108,7 @@ var _ envelope.EnvelopeHandler = &handler{}
 // newDeployer returns a new weavertest multiprocess deployer. locals contains
 // components that should be co-located with the main component and not
 // replicated.
-func newDeployer(ctx context.Context, wlet *protos.EnvelopeInfo, config *protos.AppConfig, runner Runner, locals []reflect.Type, logWriter func(*protos.LogEntry)) *deployer {
+func newDeployer(ctx context.Context, wlet *protos.EnvelopeInfo, config *protos.AppConfig, runner Runner, locals []reflect.Type, logWriter func(*protos.LogEntry), tel *Telemetry) *deployer {
 	colocation := map[string]string{}
 	for _, group := range config.Colocate {
 		for _, c := range group.Components {
@@ -122,7 +126,9 @@ func newDeployer(ctx context.Context, wlet *protos.EnvelopeInfo, config *protos.
 		groups:     map[string]*group{},
 		local:      map[string]bool{},
 		log:        logWriter,
+		telemetry:  tel,
 	}
+	tel.remote = d.metrics
 
 	for _, local := range locals {
 		name := fmt.Sprintf("%s/%s", local.PkgPath(), local.Name())
@@ -182,6 +188,9 @@ func (d *deployer) start() (runtime.Bootstrap, error) {
 		defer d.mu.Unlock()
 		g := d.group("main")
 		g.conns = append(g.conns, connection{conn: e})
+		if err := g.lead(maps.Keys(g.singletons)); err != nil {
+			d.stopLocked(fmt.Errorf(`cannot update leadership for "main": %w`, err))
+		}
 		handler := &handler{
 			deployer:   d,
 			group:      g,
@@ -237,9 +246,36 @@ func (d *deployer) HandleLogEntry(_ context.Context, entry *protos.LogEntry) err
 }
 
 // HandleTraceSpans implements the envelope.EnvelopeHandler interface.
-func (d *deployer) HandleTraceSpans(context.Context, *protos.TraceSpans) error {
-	// Ignore traces.
-	return nil
+func (d *deployer) HandleTraceSpans(_ context.Context, spans *protos.TraceSpans) error {
+	return d.telemetry.addSpans(spans)
+}
+
+// metrics returns the metrics of all subprocesses. Metrics of the main
+// weavelet, which runs in the same process as the deployer, are not included.
+func (d *deployer) metrics() ([]*metrics.MetricSnapshot, error) {
+	// Don't hold d.mu while fetching metrics. An envelope's handler may be
+	// blocked on d.mu, which would prevent the envelope from receiving the
+	// reply.
+	d.mu.Lock()
+	var envelopes []*envelope.Envelope
+	for _, g := range d.groups {
+		for _, c := range g.conns {
+			if c.envelope != nil {
+				envelopes = append(envelopes, c.envelope)
+			}
+		}
+	}
+	d.mu.Unlock()
+
+	var snapshots []*metrics.MetricSnapshot
+	for _, e := range envelopes {
+		m, err := e.GetMetrics()
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, m...)
+	}
+	return snapshots, nil
 }
 
 // GetListenerAddress implements the envelope.EnvelopeHandler interface.
@@ -276,6 +312,9 @@ func (d *deployer) registerReplica(g *group, info *protos.WeaveletInfo) error {
 		return nil
 	}
 	g.addresses[info.DialAddr] = true
+	if g.leader == "" {
+		g.leader = info.DialAddr
+	}
 
 	// Notify subscribers.
 	for component := range g.components {
@@ -298,6 +337,12 @@ func (h *handler) ActivateComponent(_ context.Context, req *protos.ActivateCompo
 	target := h.deployer.group(req.Component)
 	if !target.components[req.Component] {
 		target.components[req.Component] = true
+		if req.Singleton {
+			target.singletons[req.Component] = true
+			if err := target.lead([]string{req.Component}); err != nil {
+				return nil, err
+			}
+		}
 
 		// Notify the weavelets.
 		components := maps.Keys(target.components)
@@ -320,7 +365,7 @@ func (h *handler) ActivateComponent(_ context.Context, req *protos.ActivateCompo
 	if !h.subscribed[req.Component] {
 		h.subscribed[req.Component] = true
 
-		if !h.runner.forceRPC && h.group.name == target.name {
+		if !h.runner.forceRPC && !req.Singleton && h.group.name == target.name {
 			// Route locally.
 			routing := &protos.RoutingInfo{Component: req.Component, Local: true}
 			if err := h.conn.UpdateRoutingInfo(routing); err != nil {
@@ -383,7 +428,7 @@ func (d *deployer) startGroup(g *group) error {
 		handler.conn = c
 		g.conns = append(g.conns, c)
 	}
-	return nil
+	return g.lead(maps.Keys(g.singletons))
 }
 
 // group returns the group that corresponds to the given component.
@@ -408,6 +453,7 @@ func (d *deployer) group(component string) *group {
 			components:  map[string]bool{},
 			addresses:   map[string]bool{},
 			subscribers: map[string][]connection{},
+			singletons:  map[string]bool{},
 		}
 		d.groups[name] = g
 	}
@@ -418,12 +464,48 @@ func (d *deployer) group(component string) *group {
 //
 // REQUIRES: d.mu is held.
 func (g *group) routing(component string) *protos.RoutingInfo {
+	if g.singletons[component] {
+		// Calls to a singleton component are sent to its leader only.
+		var replicas []string
+		if g.leader != "" {
+			replicas = []string{g.leader}
+		}
+		return &protos.RoutingInfo{Component: component, Replicas: replicas}
+	}
 	return &protos.RoutingInfo{
 		Component: component,
 		Replicas:  maps.Keys(g.addresses),
 	}
 }
 
+// lead informs the group's leader, its first weavelet, that it leads the
+// provided singleton components. This deployer doesn't health check weavelets,
+// so leadership never changes. lead is a noop if the group hasn't started yet.
+//
+// REQUIRES: d.mu is held.
+func (g *group) lead(components []string) error {
+	if len(g.conns) == 0 {
+		return nil
+	}
+	for _, component := range components {
+		if err := g.conns[0].UpdateLeadership(component, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateLeadership is equivalent to Envelope.UpdateLeadership.
+func (c connection) UpdateLeadership(component string, leader bool) error {
+	if c.envelope != nil {
+		return c.envelope.UpdateLeadership(component, leader)
+	}
+	if c.conn != nil {
+		return c.conn.UpdateLeadershipRPC(component, leader)
+	}
+	panic(fmt.Errorf("nil connection"))
+}
+
 // UpdateRoutingInfo is equivalent to Envelope.UpdateRoutingInfo.
 func (c connection) UpdateRoutingInfo(routing *protos.RoutingInfo) error {
 	if c.envelope != nil {
@@ -48,6 +48,11 @@
 //     method calls will use RPCs. This mode is most useful when
 //     profiling or collecting coverage information.
 //
+//  4. LocalCopy: like Local, but method arguments and results are
+//     copied by encoding and decoding them, as they would be for RPCs.
+//     This mode catches components that mutate their callers' arguments
+//     without paying for RPCs. It is not included in AllRunners.
+//
 // Example:
 //
 //	func TestReverseSingle(t *testing.T) {
@@ -0,0 +1,140 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weavertest
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/ServiceWeaver/weaver/internal/reflection"
+	"github.com/ServiceWeaver/weaver/internal/weaver"
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+)
+
+// A Fault is an artificial failure injected into a remote component method
+// call. The zero value injects no fault.
+type Fault struct {
+	// Delay is added to the call before it is sent.
+	Delay time.Duration
+
+	// If Error is true, the call fails with a weaver.RemoteCallError without
+	// being delivered to the component.
+	Error bool
+
+	// If DropReply is true, the call is delivered to the component and
+	// executed, but its reply is dropped, and the call fails with a
+	// weaver.RemoteCallError.
+	DropReply bool
+
+	// If Unavailable is true, the call is never delivered, as if no replica
+	// of the component were reachable. The call blocks until its context is
+	// done and then fails with a weaver.RemoteCallError.
+	Unavailable bool
+}
+
+// FaultInjection records the faults to inject into calls to a component.
+type FaultInjection struct {
+	intf   reflect.Type
+	method string
+	fault  func(call int) Fault
+}
+
+// InjectFaults arranges to inject faults into remote calls to the provided
+// method of the component type T, or into calls to every method of T if method
+// is empty. For every call, fault is passed the index of the call, starting at
+// zero and counted separately for every component method, and returns the
+// fault to inject. The result is typically placed in Runner.Faults. For
+// example, the following runner fails the first call to Reverse and delays
+// every other call by a second:
+//
+//	runner := weavertest.RPC
+//	runner.Faults = append(runner.Faults, weavertest.InjectFaults[Reverser]("Reverse", func(call int) weavertest.Fault {
+//	    if call == 0 {
+//	        return weavertest.Fault{Error: true}
+//	    }
+//	    return weavertest.Fault{Delay: time.Second}
+//	}))
+//
+// Faults are injected only into remote calls. They have no effect on the Local
+// runner or on calls to co-located components. Every call attempt is counted,
+// including the extra retries issued by the RPC runner.
+//
+// Call indices are counted in the test's process. The Multi runner therefore
+// injects faults only into the calls made by the test's process, and never
+// into calls made between components running in other processes, whose
+// indices would be counted separately by every process.
+//
+// If multiple injections match a call, the first one in Runner.Faults is used.
+//
+// REQUIRES: T is a component interface, and method is empty or a method of T.
+func InjectFaults[T any](method string, fault func(call int) Fault) FaultInjection {
+	t := reflection.Type[T]()
+	if t.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("%v is not an interface", t))
+	}
+	if method != "" {
+		if _, ok := t.MethodByName(method); !ok {
+			panic(fmt.Sprintf("%v has no method %q", t, method))
+		}
+	}
+	return FaultInjection{intf: t, method: method, fault: fault}
+}
+
+// faultInjector returns a weaver.FaultInjector that injects r.Faults, or nil
+// if r has no faults. Every call to faultInjector returns an injector with
+// fresh call counts.
+func (r Runner) faultInjector() (weaver.FaultInjector, error) {
+	if len(r.Faults) == 0 {
+		return nil, nil
+	}
+
+	names := map[reflect.Type]string{}
+	for _, reg := range codegen.Registered() {
+		names[reg.Iface] = reg.Name
+	}
+	type injection struct {
+		component string
+		method    string
+		fault     func(int) Fault
+	}
+	injections := make([]injection, len(r.Faults))
+	for i, f := range r.Faults {
+		name, ok := names[f.intf]
+		if !ok {
+			return nil, fmt.Errorf("fault injection: component %v not found", f.intf)
+		}
+		injections[i] = injection{name, f.method, f.fault}
+	}
+
+	type key struct{ component, method string }
+	var mu sync.Mutex
+	calls := map[key]int{}
+	return func(component, method string) weaver.Fault {
+		for _, inj := range injections {
+			if inj.component != component || (inj.method != "" && inj.method != method) {
+				continue
+			}
+			mu.Lock()
+			k := key{component, method}
+			n := calls[k]
+			calls[k]++
+			mu.Unlock()
+			return weaver.Fault(inj.fault(n))
+		}
+		return weaver.Fault{}
+	}, nil
+}
@@ -17,6 +17,7 @@ package weavertest
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"reflect"
 	"runtime"
@@ -28,6 +29,7 @@ import (
 	"github.com/ServiceWeaver/weaver/internal/weaver"
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
 	"github.com/ServiceWeaver/weaver/runtime/logging"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
 )
 
 // Runner runs user-supplied testing code as a weaver application.
@@ -35,6 +37,7 @@ type Runner struct {
 	multi         bool // Use multiple processes
 	forceRPC      bool // Use RPCs even for local calls
 	injectRetries int  // Number of fake retries to add to remote retriable calls
+	copyOnCall    bool // Copy arguments and results of local calls
 
 	// Name is used as the name of the sub-test created by
 	// Runner.Test (or the sub-benchmark created by
@@ -53,6 +56,17 @@ type Runner struct {
 	// The typical use is to override some subset of the application
 	// code being tested with test-specific component implementations.
 	Fakes []FakeComponent
+
+	// Faults holds a list of faults to inject into remote component method
+	// calls. Faults have no effect on the Local runner, and the Multi runner
+	// injects them only into calls made by the test's process. See
+	// InjectFaults for details.
+	Faults []FaultInjection
+
+	// If not nil, the remote component method calls made by the test's
+	// process are recorded to RecordCalls. Calls are recorded only by the RPC
+	// and Multi runners. See Replay for details.
+	RecordCalls io.Writer
 }
 
 var (
@@ -60,6 +74,14 @@ var (
 	// and uses local procedure calls for method invocations.
 	Local = Runner{Name: "Local"}
 
+	// LocalCopy is a Runner that, like Local, places all components in the
+	// same process and uses local procedure calls for method invocations.
+	// Unlike Local, method arguments and results are copied by encoding and
+	// decoding them, as they would be for RPCs. This catches components that
+	// mutate their callers' arguments or otherwise depend on sharing memory,
+	// without the cost of the RPC runner.
+	LocalCopy = Runner{copyOnCall: true, Name: "LocalCopy"}
+
 	// RPC is a Runner that places all components in the same process
 	// and uses RPCs for method invocations. We also add an extra retry to
 	// every retriable RPC to discover methods that should have been
@@ -83,7 +105,8 @@ type FakeComponent struct {
 }
 
 // Fake arranges to use impl as the implementation for the component type T.
-// The result is typically placed in Runner.Fakes.
+// The result is typically placed in Runner.Fakes. The mocks generated by
+// "weaver generate --mocks" can be used as fakes.
 // REQUIRES: impl must implement T.
 func Fake[T any](impl any) FakeComponent {
 	t := reflection.Type[T]()
@@ -200,12 +223,23 @@ func (r Runner) sub(t testing.TB, isBench bool, testBody any) {
 		fakes[f.intf] = f.impl
 	}
 
+	// Logs and spans are captured only if the test body asks for a Telemetry.
+	// Capturing spans forces every span to be exported synchronously.
+	tel := newTelemetry()
+	capture := takesTelemetry(testBody)
+
 	var runner weaver.Weavelet
+	var recorder *weaver.CallRecorder
 	if !r.multi && !r.forceRPC {
 		opts := weaver.SingleWeaveletOptions{
-			Fakes:  fakes,
-			Config: r.Config,
-			Quiet:  !testing.Verbose(),
+			Fakes:      fakes,
+			Config:     r.Config,
+			Quiet:      !testing.Verbose(),
+			CopyOnCall: r.copyOnCall,
+		}
+		if capture {
+			opts.LogWriter = tel.addLog
+			opts.SpanWriter = tel.addSpans
 		}
 		var err error
 		runner, err = weaver.NewSingleWeavelet(ctx, codegen.Registered(), opts)
@@ -214,12 +248,31 @@ func (r Runner) sub(t testing.TB, isBench bool, testBody any) {
 		}
 	} else {
 		logger := logging.NewTestLogger(t, testing.Verbose())
-		bootstrap, multiCleanup, err := initMultiProcess(ctx, t, isBench, r, intfs, logger.Log)
+		logWriter := func(entry *protos.LogEntry) {
+			logger.Log(entry)
+			if capture {
+				tel.addLog(entry)
+			}
+		}
+		bootstrap, multiCleanup, err := initMultiProcess(ctx, t, isBench, capture, r, intfs, logWriter, tel)
 		if err != nil {
 			t.Fatal(err)
 		}
 
-		opts := weaver.RemoteWeaveletOptions{Fakes: fakes, InjectRetries: r.injectRetries}
+		injectFault, err := r.faultInjector()
+		if err != nil {
+			t.Fatal(err)
+		}
+		opts := weaver.RemoteWeaveletOptions{
+			Fakes:         fakes,
+			InjectRetries: r.injectRetries,
+			InjectFault:   injectFault,
+			SyncTraces:    capture,
+		}
+		if r.RecordCalls != nil {
+			recorder = weaver.NewCallRecorder(r.RecordCalls)
+			opts.RecordCalls = recorder
+		}
 		wlet, err := weaver.NewRemoteWeavelet(ctx, codegen.Registered(), bootstrap, opts)
 		if err != nil {
 			t.Fatal(err)
@@ -235,17 +288,35 @@ func (r Runner) sub(t testing.TB, isBench bool, testBody any) {
 		runner = wlet
 	}
 
-	if err := body(ctx, runner); err != nil {
+	if err := body(ctx, runner, tel); err != nil {
 		t.Fatal(err)
 	}
+	if recorder != nil {
+		if err := recorder.Err(); err != nil {
+			t.Fatalf("record calls: %v", err)
+		}
+	}
+}
+
+// takesTelemetry returns whether fn, the function passed to Runner.Test or
+// Runner.Bench, has a *Telemetry argument.
+func takesTelemetry(fn any) bool {
+	fnType := reflect.TypeOf(fn)
+	for i := 0; i < fnType.NumIn(); i++ {
+		if fnType.In(i) == telemetryType {
+			return true
+		}
+	}
+	return false
 }
 
 // checkRunFunc checks that the type of the function passed to weavertest.Run
 // is correct (its first argument matches t and its remaining arguments are
-// either component interfaces or pointer to component implementations). On
-// success it returns (1) a function that gets the components and passes them
-// to fn and (2) the interface types of the component implementation arguments.
-func checkRunFunc(t testing.TB, fn any) (func(context.Context, weaver.Weavelet) error, []reflect.Type, error) {
+// either component interfaces, pointers to component implementations, or a
+// *Telemetry). On success it returns (1) a function that gets the components
+// and passes them to fn and (2) the interface types of the component
+// implementation arguments.
+func checkRunFunc(t testing.TB, fn any) (func(context.Context, weaver.Weavelet, *Telemetry) error, []reflect.Type, error) {
 	fnType := reflect.TypeOf(fn)
 	if fnType == nil || fnType.Kind() != reflect.Func {
 		return nil, nil, fmt.Errorf("not a func")
@@ -269,21 +340,28 @@ func checkRunFunc(t testing.TB, fn any) (func(context.Context, weaver.Weavelet)
 		case reflect.Interface:
 			// Do nothing.
 		case reflect.Pointer:
+			if fnType.In(i) == telemetryType {
+				continue
+			}
 			intf, err := extractComponentInterfaceType(fnType.In(i).Elem())
 			if err != nil {
 				return nil, nil, err
 			}
 			intfs = append(intfs, intf)
 		default:
-			return nil, nil, fmt.Errorf("function argument %d type %v must be a component interface or pointer to component implementation", i, fnType.In(i))
+			return nil, nil, fmt.Errorf("function argument %d type %v must be a component interface, pointer to component implementation, or *weavertest.Telemetry", i, fnType.In(i))
 		}
 	}
 
-	return func(ctx context.Context, runner weaver.Weavelet) error {
+	return func(ctx context.Context, runner weaver.Weavelet, tel *Telemetry) error {
 		args := make([]reflect.Value, n)
 		args[0] = reflect.ValueOf(t)
 		for i := 1; i < n; i++ {
 			argType := fnType.In(i)
+			if argType == telemetryType {
+				args[i] = reflect.ValueOf(tel)
+				continue
+			}
 			switch argType.Kind() {
 			case reflect.Interface:
 				comp, err := runner.GetIntf(argType)
@@ -24,9 +24,10 @@ import (
 
 //go:generate ../../../cmd/weaver/weaver generate ./...
 
-// TestDealiasing demonstrates that pointers are only de-aliased when we use RPCs.
+// TestDealiasing demonstrates that pointers are only de-aliased when we use RPCs
+// or copy arguments and results.
 func TestDealiasing(t *testing.T) {
-	for _, runner := range weavertest.AllRunners() {
+	for _, runner := range append(weavertest.AllRunners(), weavertest.LocalCopy) {
 		runner.Test(t, func(t *testing.T, p Pointer) {
 			pair, err := p.Get(context.Background())
 			if err != nil {
@@ -40,9 +41,10 @@ func TestDealiasing(t *testing.T) {
 	}
 }
 
-// TestCustomErrors* demonstrates that custom Is methods are ignored when using RPCs.
+// TestCustomErrors* demonstrates that custom Is methods are ignored when using
+// RPCs or copying arguments and results.
 func TestCustomErrors(t *testing.T) {
-	for _, runner := range weavertest.AllRunners() {
+	for _, runner := range append(weavertest.AllRunners(), weavertest.LocalCopy) {
 		runner.Test(t, func(t *testing.T, e Errer) {
 			err := e.Err(context.Background(), 1)
 			localCalls := runner.Name == weavertest.Local.Name
@@ -42,10 +42,54 @@ type customErrorValue struct {
 
 func (c customErrorValue) Error() string { return fmt.Sprintf("customError(%s)", c.key) }
 
+// pairV1 and pairV2 are two versions of the same struct that uses tagged
+// encoding. pairV2 removes field b and adds field c.
+type pairV1 struct {
+	weaver.AutoMarshal
+	a int    `weaver:"1"`
+	b string `weaver:"2"`
+}
+
+type pairV2 struct {
+	weaver.AutoMarshal
+	a int      `weaver:"1"`
+	c []string `weaver:"3"`
+}
+
+// shape is a named interface type that is passed to and returned from
+// component methods. circle implements shape with a value receiver, and square
+// implements shape with a pointer receiver.
+type shape interface {
+	area() float64
+}
+
+type circle struct {
+	weaver.AutoMarshal
+	radius float64
+}
+
+func (c circle) area() float64 { return 3 * c.radius * c.radius }
+
+type square struct {
+	weaver.AutoMarshal
+	side float64
+}
+
+func (s *square) area() float64 { return s.side * s.side }
+
+// tree is a recursive type.
+type tree struct {
+	weaver.AutoMarshal
+	value    int
+	children []*tree
+}
+
 type testApp interface {
 	Get(_ context.Context, key string, behavior behaviorType) (int, error)
 	IncPointer(_ context.Context, arg *int) (*int, error)
 	DivMod(_ context.Context, numerator int, denominator int) (int, int, error)
+	Largest(_ context.Context, shapes []shape) (shape, error)
+	Mirror(_ context.Context, t *tree) (*tree, error)
 }
 
 type impl struct {
@@ -84,3 +128,53 @@ func (p *impl) DivMod(_ context.Context, n, d int) (int, int, error) {
 	}
 	return n / d, n % d, nil
 }
+
+// Largest returns the shape with the largest area, or nil if there are no
+// shapes.
+func (p *impl) Largest(_ context.Context, shapes []shape) (shape, error) {
+	var largest shape
+	for _, s := range shapes {
+		if largest == nil || s.area() > largest.area() {
+			largest = s
+		}
+	}
+	return largest, nil
+}
+
+// Mirror returns the mirror image of the provided tree.
+func (p *impl) Mirror(_ context.Context, t *tree) (*tree, error) {
+	var mirror func(t *tree) *tree
+	mirror = func(t *tree) *tree {
+		if t == nil {
+			return nil
+		}
+		m := &tree{value: t.value}
+		for i := len(t.children) - 1; i >= 0; i-- {
+			m.children = append(m.children, mirror(t.children[i]))
+		}
+		return m
+	}
+	return mirror(t), nil
+}
+
+// gatewayApp is served as JSON over HTTP. Its methods forward to testApp.
+type gatewayApp interface {
+	IncPointer(_ context.Context, arg *int) (*int, error)
+	DivMod(_ context.Context, numerator int, denominator int) (int, int, error)
+}
+
+// Serve gatewayApp as JSON over HTTP.
+var _ weaver.Gateway = gatewayApp(nil)
+
+type gatewayImpl struct {
+	weaver.Implements[gatewayApp]
+	app weaver.Ref[testApp]
+}
+
+func (g *gatewayImpl) IncPointer(ctx context.Context, arg *int) (*int, error) {
+	return g.app.Get().IncPointer(ctx, arg)
+}
+
+func (g *gatewayImpl) DivMod(ctx context.Context, n, d int) (int, int, error) {
+	return g.app.Get().DivMod(ctx, n, d)
+}
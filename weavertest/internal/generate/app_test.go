@@ -16,8 +16,13 @@ package generate
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -115,6 +120,83 @@ func TestPointers(t *testing.T) {
 	}
 }
 
+func TestInterfaces(t *testing.T) {
+	for _, runner := range weavertest.AllRunners() {
+		ctx := context.Background()
+		runner.Test(t, func(t *testing.T, client testApp) {
+			for _, test := range []struct {
+				name   string
+				shapes []shape
+				want   shape
+			}{
+				{"empty", nil, nil},
+				{"value", []shape{&square{side: 1}, circle{radius: 2}}, circle{radius: 2}},
+				{"pointer", []shape{circle{radius: 1}, &square{side: 2}}, &square{side: 2}},
+			} {
+				t.Run(test.name, func(t *testing.T) {
+					got, err := client.Largest(ctx, test.shapes)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !reflect.DeepEqual(got, test.want) {
+						t.Fatalf("Largest: got %#v, want %#v", got, test.want)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestRecursiveTypes(t *testing.T) {
+	for _, runner := range weavertest.AllRunners() {
+		ctx := context.Background()
+		runner.Test(t, func(t *testing.T, client testApp) {
+			leaf := func(v int) *tree { return &tree{value: v} }
+			input := &tree{value: 1, children: []*tree{leaf(2), {value: 3, children: []*tree{leaf(4), nil}}}}
+			want := &tree{value: 1, children: []*tree{{value: 3, children: []*tree{nil, leaf(4)}}, leaf(2)}}
+			got, err := client.Mirror(ctx, input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("Mirror: got %#v, want %#v", got, want)
+			}
+
+			// Deeply nested trees are supported.
+			deep := leaf(0)
+			for i := 1; i < 1000; i++ {
+				deep = &tree{value: i, children: []*tree{deep}}
+			}
+			got, err = client.Mirror(ctx, deep)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, deep) {
+				t.Fatal("Mirror: deeply nested tree changed")
+			}
+		})
+	}
+}
+
+func TestRecursiveTypesTooDeep(t *testing.T) {
+	for _, runner := range weavertest.AllRunners() {
+		if runner.Name == weavertest.Local.Name {
+			// Local calls don't serialize their arguments.
+			continue
+		}
+		ctx := context.Background()
+		runner.Test(t, func(t *testing.T, client testApp) {
+			// A cyclic tree is nested infinitely deep.
+			cyclic := &tree{value: 1}
+			cyclic.children = []*tree{cyclic}
+			_, err := client.Mirror(ctx, cyclic)
+			if err == nil || !strings.Contains(err.Error(), "levels deep") {
+				t.Fatalf("Mirror: got error %v, want nesting error", err)
+			}
+		})
+	}
+}
+
 func TestReflectStubs(t *testing.T) {
 	fakeErr := fmt.Errorf("fake error")
 	call := func(method string, _ context.Context, args, returns []any) error {
@@ -145,3 +227,100 @@ func TestReflectStubs(t *testing.T) {
 		t.Errorf("bad err: got %v, want %v", err, fakeErr)
 	}
 }
+
+func TestTaggedEncoding(t *testing.T) {
+	// Decode a pairV1 as a pairV2. Field b is skipped.
+	enc := codegen.NewEncoder()
+	v1 := pairV1{a: 1, b: "one"}
+	v1.WeaverMarshal(enc)
+	v2 := pairV2{c: []string{"stale"}}
+	v2.WeaverUnmarshal(codegen.NewDecoder(enc.Data()))
+	if want := (pairV2{a: 1}); !reflect.DeepEqual(v2, want) {
+		t.Fatalf("pairV1 -> pairV2: got %+v, want %+v", v2, want)
+	}
+
+	// Decode a pairV2 as a pairV1. Field b is missing, and field c is skipped.
+	enc = codegen.NewEncoder()
+	v2 = pairV2{a: 2, c: []string{"two"}}
+	v2.WeaverMarshal(enc)
+	v1 = pairV1{}
+	v1.WeaverUnmarshal(codegen.NewDecoder(enc.Data()))
+	if want := (pairV1{a: 2}); !reflect.DeepEqual(v1, want) {
+		t.Fatalf("pairV2 -> pairV1: got %+v, want %+v", v1, want)
+	}
+
+	// Round trip a pairV2.
+	enc = codegen.NewEncoder()
+	v2.WeaverMarshal(enc)
+	var got pairV2
+	got.WeaverUnmarshal(codegen.NewDecoder(enc.Data()))
+	if !reflect.DeepEqual(got, v2) {
+		t.Fatalf("pairV2 -> pairV2: got %+v, want %+v", got, v2)
+	}
+}
+
+func TestGateway(t *testing.T) {
+	weavertest.Local.Test(t, func(t *testing.T, client gatewayApp) {
+		server := httptest.NewServer(newGatewayAppGateway(client))
+		defer server.Close()
+
+		for _, test := range []struct {
+			name   string
+			method string
+			body   string
+			status int
+			want   string
+		}{
+			{"DivMod", "POST", "[11, 4]", http.StatusOK, "[2,3]"},
+			{"DivMod", "POST", "[1, 0]", http.StatusInternalServerError, `{"error":"divide by zero"}`},
+			{"DivMod", "POST", "[1]", http.StatusBadRequest, `{"error":"got 1 arguments, want 2"}`},
+			{"DivMod", "GET", "", http.StatusMethodNotAllowed, `{"error":"method GET not allowed"}`},
+			{"IncPointer", "POST", "[41]", http.StatusOK, "[42]"},
+			{"IncPointer", "POST", "[null]", http.StatusOK, "[null]"},
+		} {
+			t.Run(fmt.Sprintf("%s %s", test.name, test.body), func(t *testing.T) {
+				req, err := http.NewRequest(test.method, server.URL+"/gatewayApp/"+test.name, strings.NewReader(test.body))
+				if err != nil {
+					t.Fatal(err)
+				}
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer resp.Body.Close()
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if resp.StatusCode != test.status {
+					t.Errorf("status: got %d, want %d", resp.StatusCode, test.status)
+				}
+				if got := string(body); got != test.want {
+					t.Errorf("body: got %s, want %s", got, test.want)
+				}
+			})
+		}
+
+		// Check the OpenAPI description.
+		resp, err := http.Get(server.URL + "/gatewayApp/openapi.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var doc struct {
+			OpenAPI string         `json:"openapi"`
+			Paths   map[string]any `json:"paths"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+			t.Fatal(err)
+		}
+		if doc.OpenAPI != "3.1.0" {
+			t.Errorf("openapi: got %q, want 3.1.0", doc.OpenAPI)
+		}
+		for _, method := range []string{"IncPointer", "DivMod"} {
+			if _, ok := doc.Paths["/gatewayApp/"+method]; !ok {
+				t.Errorf("openapi: missing path for %s", method)
+			}
+		}
+	})
+}
@@ -11,19 +11,38 @@ import (
 	"github.com/ServiceWeaver/weaver/runtime/codegen"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
+	"net/http"
 	"reflect"
 )
 
 func init() {
+	codegen.Register(codegen.Registration{
+		Name:  "github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp",
+		Iface: reflect.TypeOf((*gatewayApp)(nil)).Elem(),
+		Impl:  reflect.TypeOf(gatewayImpl{}),
+		LocalStubFn: func(impl any, caller string, tracer trace.Tracer) any {
+			return gatewayApp_local_stub{impl: impl.(gatewayApp), tracer: tracer, divModMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp", Method: "DivMod", Remote: false}), incPointerMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp", Method: "IncPointer", Remote: false})}
+		},
+		ClientStubFn: func(stub codegen.Stub, caller string) any {
+			return gatewayApp_client_stub{stub: stub, divModMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp", Method: "DivMod", Remote: true}), incPointerMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp", Method: "IncPointer", Remote: true})}
+		},
+		ServerStubFn: func(impl any, addLoad func(uint64, float64)) codegen.Server {
+			return gatewayApp_server_stub{impl: impl.(gatewayApp), addLoad: addLoad}
+		},
+		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
+			return gatewayApp_reflect_stub{caller: caller}
+		},
+		RefData: "⟦f0d60606:wEaVeReDgE:github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp→github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp⟧\n⟦5631c47b:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp→;DivMod=068df262,IncPointer=02c245dc⟧\n",
+	})
 	codegen.Register(codegen.Registration{
 		Name:  "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp",
 		Iface: reflect.TypeOf((*testApp)(nil)).Elem(),
 		Impl:  reflect.TypeOf(impl{}),
 		LocalStubFn: func(impl any, caller string, tracer trace.Tracer) any {
-			return testApp_local_stub{impl: impl.(testApp), tracer: tracer, divModMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "DivMod", Remote: false}), getMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "Get", Remote: false}), incPointerMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "IncPointer", Remote: false})}
+			return testApp_local_stub{impl: impl.(testApp), tracer: tracer, divModMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "DivMod", Remote: false}), getMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "Get", Remote: false}), incPointerMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "IncPointer", Remote: false}), largestMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "Largest", Remote: false}), mirrorMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "Mirror", Remote: false})}
 		},
 		ClientStubFn: func(stub codegen.Stub, caller string) any {
-			return testApp_client_stub{stub: stub, divModMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "DivMod", Remote: true}), getMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "Get", Remote: true}), incPointerMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "IncPointer", Remote: true})}
+			return testApp_client_stub{stub: stub, divModMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "DivMod", Remote: true}), getMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "Get", Remote: true}), incPointerMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "IncPointer", Remote: true}), largestMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "Largest", Remote: true}), mirrorMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp", Method: "Mirror", Remote: true})}
 		},
 		ServerStubFn: func(impl any, addLoad func(uint64, float64)) codegen.Server {
 			return testApp_server_stub{impl: impl.(testApp), addLoad: addLoad}
@@ -31,24 +50,78 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return testApp_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦f039901b:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp→;DivMod=068df262,Get=6703e3b6,IncPointer=02c245dc,Largest=8d45e92e,Mirror=b56dbfe3⟧\n",
 	})
 }
 
 // weaver.InstanceOf checks.
+var _ weaver.InstanceOf[gatewayApp] = (*gatewayImpl)(nil)
 var _ weaver.InstanceOf[testApp] = (*impl)(nil)
 
 // weaver.Router checks.
+var _ weaver.Unrouted = (*gatewayImpl)(nil)
 var _ weaver.Unrouted = (*impl)(nil)
 
 // Local stub implementations.
 
+type gatewayApp_local_stub struct {
+	impl              gatewayApp
+	tracer            trace.Tracer
+	divModMetrics     *codegen.MethodMetrics
+	incPointerMetrics *codegen.MethodMetrics
+}
+
+// Check that gatewayApp_local_stub implements the gatewayApp interface.
+var _ gatewayApp = (*gatewayApp_local_stub)(nil)
+
+func (s gatewayApp_local_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int, r1 int, err error) {
+	// Update metrics.
+	begin := s.divModMetrics.Begin()
+	defer func() { s.divModMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "generate.gatewayApp.DivMod", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp")))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
+
+	return s.impl.DivMod(ctx, a0, a1)
+}
+
+func (s gatewayApp_local_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int, err error) {
+	// Update metrics.
+	begin := s.incPointerMetrics.Begin()
+	defer func() { s.incPointerMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "generate.gatewayApp.IncPointer", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp")))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
+
+	return s.impl.IncPointer(ctx, a0)
+}
+
 type testApp_local_stub struct {
 	impl              testApp
 	tracer            trace.Tracer
 	divModMetrics     *codegen.MethodMetrics
 	getMetrics        *codegen.MethodMetrics
 	incPointerMetrics *codegen.MethodMetrics
+	largestMetrics    *codegen.MethodMetrics
+	mirrorMetrics     *codegen.MethodMetrics
 }
 
 // Check that testApp_local_stub implements the testApp interface.
@@ -61,7 +134,7 @@ func (s testApp_local_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int,
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "generate.testApp.DivMod", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "generate.testApp.DivMod", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -81,7 +154,7 @@ func (s testApp_local_stub) Get(ctx context.Context, a0 string, a1 behaviorType)
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "generate.testApp.Get", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "generate.testApp.Get", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -101,7 +174,7 @@ func (s testApp_local_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int, e
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "generate.testApp.IncPointer", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "generate.testApp.IncPointer", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -114,13 +187,179 @@ func (s testApp_local_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int, e
 	return s.impl.IncPointer(ctx, a0)
 }
 
+func (s testApp_local_stub) Largest(ctx context.Context, a0 []shape) (r0 shape, err error) {
+	// Update metrics.
+	begin := s.largestMetrics.Begin()
+	defer func() { s.largestMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "generate.testApp.Largest", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
+
+	return s.impl.Largest(ctx, a0)
+}
+
+func (s testApp_local_stub) Mirror(ctx context.Context, a0 *tree) (r0 *tree, err error) {
+	// Update metrics.
+	begin := s.mirrorMetrics.Begin()
+	defer func() { s.mirrorMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "generate.testApp.Mirror", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
+
+	return s.impl.Mirror(ctx, a0)
+}
+
 // Client stub implementations.
 
+type gatewayApp_client_stub struct {
+	stub              codegen.Stub
+	divModMetrics     *codegen.MethodMetrics
+	incPointerMetrics *codegen.MethodMetrics
+}
+
+// Check that gatewayApp_client_stub implements the gatewayApp interface.
+var _ gatewayApp = (*gatewayApp_client_stub)(nil)
+
+func (s gatewayApp_client_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int, r1 int, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.divModMetrics.Begin()
+	defer func() { s.divModMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "generate.gatewayApp.DivMod", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp")))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(weaver.RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Preallocate a buffer of the right size.
+	size := 0
+	size += 8
+	size += 8
+	enc := codegen.NewEncoder()
+	enc.Reset(size)
+
+	// Encode arguments.
+	enc.Int(a0)
+	enc.Int(a1)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 0, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(weaver.RemoteCallError, err)
+		return
+	}
+
+	// Decode the results.
+	dec := codegen.NewDecoder(results)
+	r0 = dec.Int()
+	r1 = dec.Int()
+	err = dec.Error()
+	return
+}
+
+func (s gatewayApp_client_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.incPointerMetrics.Begin()
+	defer func() { s.incPointerMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "generate.gatewayApp.IncPointer", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp")))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(weaver.RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Preallocate a buffer of the right size.
+	size := 0
+	size += serviceweaver_size_ptr_int_98a2a745(a0)
+	enc := codegen.NewEncoder()
+	enc.Reset(size)
+
+	// Encode arguments.
+	serviceweaver_enc_ptr_int_98a2a745(enc, a0)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 1, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(weaver.RemoteCallError, err)
+		return
+	}
+
+	// Decode the results.
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_int_98a2a745(dec)
+	err = dec.Error()
+	return
+}
+
 type testApp_client_stub struct {
 	stub              codegen.Stub
 	divModMetrics     *codegen.MethodMetrics
 	getMetrics        *codegen.MethodMetrics
 	incPointerMetrics *codegen.MethodMetrics
+	largestMetrics    *codegen.MethodMetrics
+	mirrorMetrics     *codegen.MethodMetrics
 }
 
 // Check that testApp_client_stub implements the testApp interface.
@@ -135,7 +374,7 @@ func (s testApp_client_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "generate.testApp.DivMod", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "generate.testApp.DivMod", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
 	}
 
 	defer func() {
@@ -194,7 +433,7 @@ func (s testApp_client_stub) Get(ctx context.Context, a0 string, a1 behaviorType
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "generate.testApp.Get", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "generate.testApp.Get", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
 	}
 
 	defer func() {
@@ -252,7 +491,7 @@ func (s testApp_client_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int,
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "generate.testApp.IncPointer", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "generate.testApp.IncPointer", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
 	}
 
 	defer func() {
@@ -299,39 +538,214 @@ func (s testApp_client_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int,
 	return
 }
 
-// Note that "weaver generate" will always generate the error message below.
-// Everything is okay. The error message is only relevant if you see it when
-// you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][20]struct{}](`
-
-ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.20.0). The generated code is incompatible with the version of the
-github.com/ServiceWeaver/weaver module that you're using. The weaver module
-version can be found in your go.mod file or by running the following command.
+func (s testApp_client_stub) Largest(ctx context.Context, a0 []shape) (r0 shape, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.largestMetrics.Begin()
+	defer func() { s.largestMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
 
-    go list -m github.com/ServiceWeaver/weaver
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "generate.testApp.Largest", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
+	}
 
-We recommend updating the weaver module and the 'weaver generate' command by
-running the following.
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(weaver.RemoteCallError, err)
+			}
+		}
 
-    go get github.com/ServiceWeaver/weaver@latest
-    go install github.com/ServiceWeaver/weaver/cmd/weaver@latest
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
-Then, re-run 'weaver generate' and re-build your code. If the problem persists,
-please file an issue at https://github.com/ServiceWeaver/weaver/issues.
+	}()
 
-`)
+	// Encode arguments.
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_slice_shape_d559c43f(enc, a0)
+	var shardKey uint64
 
-// Server stub implementations.
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 3, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(weaver.RemoteCallError, err)
+		return
+	}
 
-type testApp_server_stub struct {
-	impl    testApp
-	addLoad func(key uint64, load float64)
+	// Decode the results.
+	dec := codegen.NewDecoder(results)
+	r0 = codegen.DecodeInterfaceValue[shape](dec)
+	err = dec.Error()
+	return
 }
 
-// Check that testApp_server_stub implements the codegen.Server interface.
-var _ codegen.Server = (*testApp_server_stub)(nil)
-
+func (s testApp_client_stub) Mirror(ctx context.Context, a0 *tree) (r0 *tree, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.mirrorMetrics.Begin()
+	defer func() { s.mirrorMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "generate.testApp.Mirror", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/generate/testApp")))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(weaver.RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	// Encode arguments.
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_tree_ce2e44aa(enc, a0)
+	var shardKey uint64
+
+	// Call the remote method.
+	requestBytes = len(enc.Data())
+	var results []byte
+	results, err = s.stub.Run(ctx, 4, enc.Data(), shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(weaver.RemoteCallError, err)
+		return
+	}
+
+	// Decode the results.
+	dec := codegen.NewDecoder(results)
+	r0 = serviceweaver_dec_ptr_tree_ce2e44aa(dec)
+	err = dec.Error()
+	return
+}
+
+// Note that "weaver generate" will always generate the error message below.
+// Everything is okay. The error message is only relevant if you see it when
+// you run "go build" or "go run".
+var _ codegen.LatestVersion = codegen.Version[[0][23]struct{}](`
+
+ERROR: You generated this file with 'weaver generate' (devel) (codegen
+version v0.23.0). The generated code is incompatible with the version of the
+github.com/ServiceWeaver/weaver module that you're using. The weaver module
+version can be found in your go.mod file or by running the following command.
+
+    go list -m github.com/ServiceWeaver/weaver
+
+We recommend updating the weaver module and the 'weaver generate' command by
+running the following.
+
+    go get github.com/ServiceWeaver/weaver@latest
+    go install github.com/ServiceWeaver/weaver/cmd/weaver@latest
+
+Then, re-run 'weaver generate' and re-build your code. If the problem persists,
+please file an issue at https://github.com/ServiceWeaver/weaver/issues.
+
+`)
+
+// Server stub implementations.
+
+type gatewayApp_server_stub struct {
+	impl    gatewayApp
+	addLoad func(key uint64, load float64)
+}
+
+// Check that gatewayApp_server_stub implements the codegen.Server interface.
+var _ codegen.Server = (*gatewayApp_server_stub)(nil)
+
+// GetStubFn implements the codegen.Server interface.
+func (s gatewayApp_server_stub) GetStubFn(method string) func(ctx context.Context, args []byte) ([]byte, error) {
+	switch method {
+	case "DivMod":
+		return s.divMod
+	case "IncPointer":
+		return s.incPointer
+	default:
+		return nil
+	}
+}
+
+func (s gatewayApp_server_stub) divMod(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	dec := codegen.NewDecoder(args)
+	var a0 int
+	a0 = dec.Int()
+	var a1 int
+	a1 = dec.Int()
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, r1, appErr := s.impl.DivMod(ctx, a0, a1)
+
+	// Encode the results.
+	enc := codegen.NewEncoder()
+	enc.Int(r0)
+	enc.Int(r1)
+	enc.Error(appErr)
+	return enc.Data(), nil
+}
+
+func (s gatewayApp_server_stub) incPointer(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	dec := codegen.NewDecoder(args)
+	var a0 *int
+	a0 = serviceweaver_dec_ptr_int_98a2a745(dec)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.IncPointer(ctx, a0)
+
+	// Encode the results.
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_int_98a2a745(enc, r0)
+	enc.Error(appErr)
+	return enc.Data(), nil
+}
+
+type testApp_server_stub struct {
+	impl    testApp
+	addLoad func(key uint64, load float64)
+}
+
+// Check that testApp_server_stub implements the codegen.Server interface.
+var _ codegen.Server = (*testApp_server_stub)(nil)
+
 // GetStubFn implements the codegen.Server interface.
 func (s testApp_server_stub) GetStubFn(method string) func(ctx context.Context, args []byte) ([]byte, error) {
 	switch method {
@@ -341,6 +755,10 @@ func (s testApp_server_stub) GetStubFn(method string) func(ctx context.Context,
 		return s.get
 	case "IncPointer":
 		return s.incPointer
+	case "Largest":
+		return s.largest
+	case "Mirror":
+		return s.mirror
 	default:
 		return nil
 	}
@@ -426,8 +844,75 @@ func (s testApp_server_stub) incPointer(ctx context.Context, args []byte) (res [
 	return enc.Data(), nil
 }
 
+func (s testApp_server_stub) largest(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	dec := codegen.NewDecoder(args)
+	var a0 []shape
+	a0 = serviceweaver_dec_slice_shape_d559c43f(dec)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.Largest(ctx, a0)
+
+	// Encode the results.
+	enc := codegen.NewEncoder()
+	enc.InterfaceValue(r0)
+	enc.Error(appErr)
+	return enc.Data(), nil
+}
+
+func (s testApp_server_stub) mirror(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// Decode arguments.
+	dec := codegen.NewDecoder(args)
+	var a0 *tree
+	a0 = serviceweaver_dec_ptr_tree_ce2e44aa(dec)
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, appErr := s.impl.Mirror(ctx, a0)
+
+	// Encode the results.
+	enc := codegen.NewEncoder()
+	serviceweaver_enc_ptr_tree_ce2e44aa(enc, r0)
+	enc.Error(appErr)
+	return enc.Data(), nil
+}
+
 // Reflect stub implementations.
 
+type gatewayApp_reflect_stub struct {
+	caller func(string, context.Context, []any, []any) error
+}
+
+// Check that gatewayApp_reflect_stub implements the gatewayApp interface.
+var _ gatewayApp = (*gatewayApp_reflect_stub)(nil)
+
+func (s gatewayApp_reflect_stub) DivMod(ctx context.Context, a0 int, a1 int) (r0 int, r1 int, err error) {
+	err = s.caller("DivMod", ctx, []any{a0, a1}, []any{&r0, &r1})
+	return
+}
+
+func (s gatewayApp_reflect_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int, err error) {
+	err = s.caller("IncPointer", ctx, []any{a0}, []any{&r0})
+	return
+}
+
 type testApp_reflect_stub struct {
 	caller func(string, context.Context, []any, []any) error
 }
@@ -450,8 +935,211 @@ func (s testApp_reflect_stub) IncPointer(ctx context.Context, a0 *int) (r0 *int,
 	return
 }
 
+func (s testApp_reflect_stub) Largest(ctx context.Context, a0 []shape) (r0 shape, err error) {
+	err = s.caller("Largest", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+func (s testApp_reflect_stub) Mirror(ctx context.Context, a0 *tree) (r0 *tree, err error) {
+	err = s.caller("Mirror", ctx, []any{a0}, []any{&r0})
+	return
+}
+
+// Gateways.
+
+// newGatewayAppGateway returns an http.Handler that serves the methods of the gatewayApp
+// component as JSON over HTTP. See weaver.Gateway for details.
+func newGatewayAppGateway(c gatewayApp) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/gatewayApp/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		codegen.WriteGatewayOpenAPI(w, r, gatewayApp_openapi)
+	})
+	mux.HandleFunc("/gatewayApp/DivMod", func(w http.ResponseWriter, r *http.Request) {
+		var a0 int
+		var a1 int
+		if !codegen.ReadGatewayArgs(w, r, &a0, &a1) {
+			return
+		}
+		r0, r1, err := c.DivMod(r.Context(), a0, a1)
+		codegen.WriteGatewayResults(w, err, r0, r1)
+	})
+	mux.HandleFunc("/gatewayApp/IncPointer", func(w http.ResponseWriter, r *http.Request) {
+		var a0 *int
+		if !codegen.ReadGatewayArgs(w, r, &a0) {
+			return
+		}
+		r0, err := c.IncPointer(r.Context(), a0)
+		codegen.WriteGatewayResults(w, err, r0)
+	})
+	return mux
+}
+
+// gatewayApp_openapi is the OpenAPI description of the gatewayApp gateway.
+const gatewayApp_openapi = `{
+  "components": {
+    "schemas": {
+      "Error": {
+        "properties": {
+          "error": {
+            "type": "string"
+          }
+        },
+        "required": [
+          "error"
+        ],
+        "type": "object"
+      }
+    }
+  },
+  "info": {
+    "title": "github.com/ServiceWeaver/weaver/weavertest/internal/generate/gatewayApp",
+    "version": "1de700c4"
+  },
+  "openapi": "3.1.0",
+  "paths": {
+    "/gatewayApp/DivMod": {
+      "post": {
+        "operationId": "DivMod",
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "maxItems": 2,
+                "minItems": 2,
+                "prefixItems": [
+                  {
+                    "format": "int64",
+                    "type": "integer"
+                  },
+                  {
+                    "format": "int64",
+                    "type": "integer"
+                  }
+                ],
+                "type": "array"
+              }
+            }
+          },
+          "required": true
+        },
+        "responses": {
+          "200": {
+            "content": {
+              "application/json": {
+                "schema": {
+                  "maxItems": 2,
+                  "minItems": 2,
+                  "prefixItems": [
+                    {
+                      "format": "int64",
+                      "type": "integer"
+                    },
+                    {
+                      "format": "int64",
+                      "type": "integer"
+                    }
+                  ],
+                  "type": "array"
+                }
+              }
+            },
+            "description": "The results of the call."
+          },
+          "default": {
+            "content": {
+              "application/json": {
+                "schema": {
+                  "$ref": "#/components/schemas/Error"
+                }
+              }
+            },
+            "description": "The call failed."
+          }
+        }
+      }
+    },
+    "/gatewayApp/IncPointer": {
+      "post": {
+        "operationId": "IncPointer",
+        "requestBody": {
+          "content": {
+            "application/json": {
+              "schema": {
+                "maxItems": 1,
+                "minItems": 1,
+                "prefixItems": [
+                  {
+                    "format": "int64",
+                    "type": "integer"
+                  }
+                ],
+                "type": "array"
+              }
+            }
+          },
+          "required": true
+        },
+        "responses": {
+          "200": {
+            "content": {
+              "application/json": {
+                "schema": {
+                  "maxItems": 1,
+                  "minItems": 1,
+                  "prefixItems": [
+                    {
+                      "format": "int64",
+                      "type": "integer"
+                    }
+                  ],
+                  "type": "array"
+                }
+              }
+            },
+            "description": "The results of the call."
+          },
+          "default": {
+            "content": {
+              "application/json": {
+                "schema": {
+                  "$ref": "#/components/schemas/Error"
+                }
+              }
+            },
+            "description": "The call failed."
+          }
+        }
+      }
+    }
+  }
+}`
+
 // AutoMarshal implementations.
 
+var _ codegen.AutoMarshal = (*circle)(nil)
+
+type __is_circle[T ~struct {
+	weaver.AutoMarshal
+	radius float64
+}] struct{}
+
+var _ __is_circle[circle]
+
+func (x *circle) WeaverMarshal(enc *codegen.Encoder) {
+	if x == nil {
+		panic(fmt.Errorf("circle.WeaverMarshal: nil receiver"))
+	}
+	enc.Float64(x.radius)
+}
+
+func (x *circle) WeaverUnmarshal(dec *codegen.Decoder) {
+	if x == nil {
+		panic(fmt.Errorf("circle.WeaverUnmarshal: nil receiver"))
+	}
+	x.radius = dec.Float64()
+}
+func init() { codegen.RegisterSerializable[*circle]() }
+
 var _ codegen.AutoMarshal = (*customErrorValue)(nil)
 
 type __is_customErrorValue[T ~struct {
@@ -476,6 +1164,218 @@ func (x *customErrorValue) WeaverUnmarshal(dec *codegen.Decoder) {
 }
 func init() { codegen.RegisterSerializable[*customErrorValue]() }
 
+var _ codegen.AutoMarshal = (*pairV1)(nil)
+
+type __is_pairV1[T ~struct {
+	weaver.AutoMarshal
+	a int    "weaver:\"1\""
+	b string "weaver:\"2\""
+}] struct{}
+
+var _ __is_pairV1[pairV1]
+
+func (x *pairV1) WeaverMarshal(enc *codegen.Encoder) {
+	if x == nil {
+		panic(fmt.Errorf("pairV1.WeaverMarshal: nil receiver"))
+	}
+	{
+		start := enc.BeginField(1)
+		enc.Int(x.a)
+		enc.EndField(start)
+	}
+	{
+		start := enc.BeginField(2)
+		enc.String(x.b)
+		enc.EndField(start)
+	}
+	enc.EndFields()
+}
+
+func (x *pairV1) WeaverUnmarshal(dec *codegen.Decoder) {
+	if x == nil {
+		panic(fmt.Errorf("pairV1.WeaverUnmarshal: nil receiver"))
+	}
+	*x = pairV1{}
+	for {
+		tag, field := dec.Field()
+		switch tag {
+		case 0:
+			return
+		case 1:
+			x.a = field.Int()
+		case 2:
+			x.b = field.String()
+		}
+	}
+}
+
+var _ codegen.AutoMarshal = (*pairV2)(nil)
+
+type __is_pairV2[T ~struct {
+	weaver.AutoMarshal
+	a int      "weaver:\"1\""
+	c []string "weaver:\"3\""
+}] struct{}
+
+var _ __is_pairV2[pairV2]
+
+func (x *pairV2) WeaverMarshal(enc *codegen.Encoder) {
+	if x == nil {
+		panic(fmt.Errorf("pairV2.WeaverMarshal: nil receiver"))
+	}
+	{
+		start := enc.BeginField(1)
+		enc.Int(x.a)
+		enc.EndField(start)
+	}
+	{
+		start := enc.BeginField(3)
+		serviceweaver_enc_slice_string_4af10117(enc, x.c)
+		enc.EndField(start)
+	}
+	enc.EndFields()
+}
+
+func (x *pairV2) WeaverUnmarshal(dec *codegen.Decoder) {
+	if x == nil {
+		panic(fmt.Errorf("pairV2.WeaverUnmarshal: nil receiver"))
+	}
+	*x = pairV2{}
+	for {
+		tag, field := dec.Field()
+		switch tag {
+		case 0:
+			return
+		case 1:
+			x.a = field.Int()
+		case 3:
+			x.c = serviceweaver_dec_slice_string_4af10117(field)
+		}
+	}
+}
+
+func serviceweaver_enc_slice_string_4af10117(enc *codegen.Encoder, arg []string) {
+	if arg == nil {
+		enc.Len(-1)
+		return
+	}
+	enc.Len(len(arg))
+	for i := 0; i < len(arg); i++ {
+		enc.String(arg[i])
+	}
+}
+
+func serviceweaver_dec_slice_string_4af10117(dec *codegen.Decoder) []string {
+	n := dec.Len()
+	if n == -1 {
+		return nil
+	}
+	res := make([]string, n)
+	for i := 0; i < n; i++ {
+		res[i] = dec.String()
+	}
+	return res
+}
+
+var _ codegen.AutoMarshal = (*square)(nil)
+
+type __is_square[T ~struct {
+	weaver.AutoMarshal
+	side float64
+}] struct{}
+
+var _ __is_square[square]
+
+func (x *square) WeaverMarshal(enc *codegen.Encoder) {
+	if x == nil {
+		panic(fmt.Errorf("square.WeaverMarshal: nil receiver"))
+	}
+	enc.Float64(x.side)
+}
+
+func (x *square) WeaverUnmarshal(dec *codegen.Decoder) {
+	if x == nil {
+		panic(fmt.Errorf("square.WeaverUnmarshal: nil receiver"))
+	}
+	x.side = dec.Float64()
+}
+func init() { codegen.RegisterSerializable[*square]() }
+
+var _ codegen.AutoMarshal = (*tree)(nil)
+
+type __is_tree[T ~struct {
+	weaver.AutoMarshal
+	value    int
+	children []*tree
+}] struct{}
+
+var _ __is_tree[tree]
+
+func (x *tree) WeaverMarshal(enc *codegen.Encoder) {
+	if x == nil {
+		panic(fmt.Errorf("tree.WeaverMarshal: nil receiver"))
+	}
+	enc.Int(x.value)
+	serviceweaver_enc_slice_ptr_tree_6a52a96b(enc, x.children)
+}
+
+func (x *tree) WeaverUnmarshal(dec *codegen.Decoder) {
+	if x == nil {
+		panic(fmt.Errorf("tree.WeaverUnmarshal: nil receiver"))
+	}
+	x.value = dec.Int()
+	x.children = serviceweaver_dec_slice_ptr_tree_6a52a96b(dec)
+}
+
+func serviceweaver_enc_ptr_tree_ce2e44aa(enc *codegen.Encoder, arg *tree) {
+	enc.BeginNested()
+	defer enc.EndNested()
+	if arg == nil {
+		enc.Bool(false)
+	} else {
+		enc.Bool(true)
+		(*arg).WeaverMarshal(enc)
+	}
+}
+
+func serviceweaver_dec_ptr_tree_ce2e44aa(dec *codegen.Decoder) *tree {
+	dec.BeginNested()
+	defer dec.EndNested()
+	if !dec.Bool() {
+		return nil
+	}
+	var res tree
+	(&res).WeaverUnmarshal(dec)
+	return &res
+}
+
+func serviceweaver_enc_slice_ptr_tree_6a52a96b(enc *codegen.Encoder, arg []*tree) {
+	enc.BeginNested()
+	defer enc.EndNested()
+	if arg == nil {
+		enc.Len(-1)
+		return
+	}
+	enc.Len(len(arg))
+	for i := 0; i < len(arg); i++ {
+		serviceweaver_enc_ptr_tree_ce2e44aa(enc, arg[i])
+	}
+}
+
+func serviceweaver_dec_slice_ptr_tree_6a52a96b(dec *codegen.Decoder) []*tree {
+	dec.BeginNested()
+	defer dec.EndNested()
+	n := dec.Len()
+	if n == -1 {
+		return nil
+	}
+	res := make([]*tree, n)
+	for i := 0; i < n; i++ {
+		res[i] = serviceweaver_dec_ptr_tree_ce2e44aa(dec)
+	}
+	return res
+}
+
 // Encoding/decoding implementations.
 
 func serviceweaver_enc_ptr_int_98a2a745(enc *codegen.Encoder, arg *int) {
@@ -496,6 +1396,29 @@ func serviceweaver_dec_ptr_int_98a2a745(dec *codegen.Decoder) *int {
 	return &res
 }
 
+func serviceweaver_enc_slice_shape_d559c43f(enc *codegen.Encoder, arg []shape) {
+	if arg == nil {
+		enc.Len(-1)
+		return
+	}
+	enc.Len(len(arg))
+	for i := 0; i < len(arg); i++ {
+		enc.InterfaceValue(arg[i])
+	}
+}
+
+func serviceweaver_dec_slice_shape_d559c43f(dec *codegen.Decoder) []shape {
+	n := dec.Len()
+	if n == -1 {
+		return nil
+	}
+	res := make([]shape, n)
+	for i := 0; i < n; i++ {
+		res[i] = codegen.DecodeInterfaceValue[shape](dec)
+	}
+	return res
+}
+
 // Size implementations.
 
 // serviceweaver_size_ptr_int_98a2a745 returns the size (in bytes) of the serialization
@@ -30,7 +30,7 @@ import (
 	"github.com/ServiceWeaver/weaver"
 )
 
-//go:generate ../../../cmd/weaver/weaver generate
+//go:generate ../../../cmd/weaver/weaver generate --mocks
 
 type Source interface {
 	Emit(ctx context.Context, file, msg string) error
@@ -155,3 +155,19 @@ func (s *server) Init(ctx context.Context) error {
 func (s *server) Address(ctx context.Context) (string, error)      { return s.addr, nil }
 func (s *server) ProxyAddress(ctx context.Context) (string, error) { return s.proxy, nil }
 func (s *server) Shutdown(ctx context.Context) error               { return s.srv.Shutdown(ctx) }
+
+// Reconciler is a singleton component.
+type Reconciler interface {
+	// Leader returns the pid of the replica that handled the call and
+	// whether the replica is the leader.
+	Leader(context.Context) (int, bool, error)
+}
+
+type reconciler struct {
+	weaver.Implements[Reconciler]
+	weaver.Singleton
+}
+
+func (r *reconciler) Leader(context.Context) (int, bool, error) {
+	return os.Getpid(), r.IsLeader(), nil
+}
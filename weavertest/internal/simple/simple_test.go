@@ -16,6 +16,7 @@ package simple_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -26,10 +27,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ServiceWeaver/weaver"
 	"github.com/ServiceWeaver/weaver/internal/traceio"
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
 	"github.com/ServiceWeaver/weaver/weavertest"
 	"github.com/ServiceWeaver/weaver/weavertest/internal/simple"
 	"github.com/google/uuid"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 func TestOneComponent(t *testing.T) {
@@ -77,6 +82,109 @@ func TestFake(t *testing.T) {
 	}
 }
 
+func TestMock(t *testing.T) {
+	for _, runner := range weavertest.AllRunners() {
+		mock := &simple.MockDestination{}
+		mock.ExpectRecord(func(ctx context.Context, file, msg string) error {
+			return fmt.Errorf("disk full")
+		})
+		mock.StubRecord(func(ctx context.Context, file, msg string) error {
+			return nil
+		})
+		runner.Fakes = append(runner.Fakes, weavertest.Fake[simple.Destination](mock))
+		runner.Test(t, func(t *testing.T, src simple.Source) {
+			ctx := context.Background()
+			if err := src.Emit(ctx, "file", "a"); err == nil || !strings.Contains(err.Error(), "disk full") {
+				t.Fatalf("Emit: got %v, want disk full error", err)
+			}
+			if err := src.Emit(ctx, "file", "b"); err != nil {
+				t.Fatal(err)
+			}
+			want := []simple.MockDestinationRecordCall{{A0: "file", A1: "a"}, {A0: "file", A1: "b"}}
+			if got := mock.RecordCalls(); !reflect.DeepEqual(got, want) {
+				t.Fatalf("RecordCalls: got %v, want %v", got, want)
+			}
+			if err := mock.Verify(); err != nil {
+				t.Fatal(err)
+			}
+
+			// Calls that are neither expected nor stubbed fail.
+			if _, err := mock.GetAll(ctx, "file"); err == nil {
+				t.Fatal("GetAll: unexpected success")
+			}
+			if err := mock.Verify(); err == nil {
+				t.Fatal("Verify: unexpected success")
+			}
+		})
+	}
+}
+
+// errorsTB is a testing.TB that records the errors reported with Errorf.
+type errorsTB struct {
+	testing.TB
+	errors []string
+}
+
+func (e *errorsTB) Errorf(format string, args ...any) {
+	e.errors = append(e.errors, fmt.Sprintf(format, args...))
+}
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	calls := filepath.Join(dir, "calls")
+	file := filepath.Join(dir, "file")
+
+	// Record some calls.
+	f, err := os.Create(calls)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runner := weavertest.RPC
+	runner.RecordCalls = f
+	runner.Test(t, func(t *testing.T, dst simple.Destination) {
+		if err := dst.Record(ctx, file, "a"); err != nil {
+			t.Fatal(err)
+		}
+		if err := dst.RoutedRecord(ctx, file, "b"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := dst.GetAll(ctx, file); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	weavertest.Local.Test(t, func(t *testing.T, tel *weavertest.Telemetry, dst simple.Destination) {
+		// Replaying the calls from scratch produces the same results.
+		if err := os.Remove(file); err != nil {
+			t.Fatal(err)
+		}
+		weavertest.Replay(t, calls, dst)
+
+		// Decoding the results isn't recorded as a call.
+		snapshots, err := tel.Metrics()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range snapshots {
+			if m.Name == codegen.MethodCountsName && m.Labels["remote"] == "true" && m.Value != 0 {
+				t.Errorf("Replay recorded %v calls to %s", m.Value, m.Labels["method"])
+			}
+		}
+
+		// Replaying the calls again appends to the existing file, so GetAll
+		// returns different results.
+		tb := &errorsTB{TB: t}
+		weavertest.Replay(tb, calls, dst)
+		if len(tb.errors) != 1 || !strings.Contains(tb.errors[0], "GetAll") {
+			t.Fatalf("Replay: got errors %q, want one GetAll error", tb.errors)
+		}
+	})
+}
+
 func TestTwoComponents(t *testing.T) {
 	// Add a list of items to a component (dst) from another component (src). Verify that
 	// dst updates the state accordingly.
@@ -185,6 +293,154 @@ func TestRoutedCall(t *testing.T) {
 	}
 }
 
+func TestFaults(t *testing.T) {
+	// Inject a different fault into each call to Record.
+	ctx := context.Background()
+	for _, runner := range []weavertest.Runner{weavertest.RPC, weavertest.Multi} {
+		runner.Faults = append(runner.Faults, weavertest.InjectFaults[simple.Destination]("Record", func(call int) weavertest.Fault {
+			switch call {
+			case 0:
+				return weavertest.Fault{Error: true}
+			case 1:
+				return weavertest.Fault{DropReply: true}
+			case 2:
+				return weavertest.Fault{Unavailable: true}
+			default:
+				return weavertest.Fault{Delay: 10 * time.Millisecond}
+			}
+		}))
+		runner.Test(t, func(t *testing.T, dst simple.Destination) {
+			file := filepath.Join(t.TempDir(), fmt.Sprintf("simple_%s", uuid.New().String()))
+			for _, test := range []struct {
+				msg     string
+				wantErr bool
+			}{
+				{"error", true},
+				{"drop", true},
+				{"unavailable", true},
+				{"delay", false},
+			} {
+				ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+				err := dst.Record(ctx, file, test.msg)
+				cancel()
+				switch {
+				case test.wantErr && !errors.Is(err, weaver.RemoteCallError):
+					t.Errorf("Record(%q): got %v, want RemoteCallError", test.msg, err)
+				case !test.wantErr && err != nil:
+					t.Errorf("Record(%q): %v", test.msg, err)
+				}
+			}
+
+			// Only the dropped and delayed calls were delivered.
+			got, err := dst.GetAll(ctx, file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := []string{"drop", "delay"}; !reflect.DeepEqual(want, got) {
+				t.Fatalf("GetAll() = %v; expecting %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTelemetry(t *testing.T) {
+	const component = "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination"
+	for _, runner := range weavertest.AllRunners() {
+		runner.Test(t, func(t *testing.T, tel *weavertest.Telemetry, dst simple.Destination) {
+			// Component methods only produce spans when called with a span.
+			ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+			file := filepath.Join(t.TempDir(), fmt.Sprintf("simple_%s", uuid.New().String()))
+			if err := dst.Record(ctx, file, "hello"); err != nil {
+				t.Fatal(err)
+			}
+			span.End()
+
+			// Logs and spans of other processes are delivered asynchronously.
+			hasLog := func() bool {
+				for _, entry := range tel.Logs() {
+					if entry.Component == component && entry.Msg == "record" {
+						return true
+					}
+				}
+				return false
+			}
+			hasSpan := func() bool {
+				for _, span := range tel.Spans() {
+					if span.Name == "simple.Destination.Record" {
+						return true
+					}
+				}
+				return false
+			}
+			for start := time.Now(); !hasLog() || !hasSpan(); time.Sleep(10 * time.Millisecond) {
+				if time.Since(start) > 10*time.Second {
+					t.Fatalf("log found = %v, span found = %v", hasLog(), hasSpan())
+				}
+			}
+
+			snapshots, err := tel.Metrics()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var count float64
+			for _, m := range snapshots {
+				if m.Name == codegen.MethodCountsName && m.Labels["component"] == component && m.Labels["method"] == "Record" {
+					count += m.Value
+				}
+			}
+			if count == 0 {
+				t.Fatalf("%s for Record not found", codegen.MethodCountsName)
+			}
+		})
+	}
+}
+
+func TestLocalCopyTelemetry(t *testing.T) {
+	// Calls made by the LocalCopy runner are recorded as local calls.
+	const component = "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination"
+	weavertest.LocalCopy.Test(t, func(t *testing.T, tel *weavertest.Telemetry, dst simple.Destination) {
+		counts := func() map[string]float64 {
+			snapshots, err := tel.Metrics()
+			if err != nil {
+				t.Fatal(err)
+			}
+			counts := map[string]float64{}
+			for _, m := range snapshots {
+				if m.Name == codegen.MethodCountsName && m.Labels["component"] == component && m.Labels["method"] == "Record" {
+					counts[m.Labels["remote"]] += m.Value
+				}
+			}
+			return counts
+		}
+		before := counts()
+
+		ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+		file := filepath.Join(t.TempDir(), fmt.Sprintf("simple_%s", uuid.New().String()))
+		if err := dst.Record(ctx, file, "hello"); err != nil {
+			t.Fatal(err)
+		}
+		span.End()
+
+		after := counts()
+		if got, want := after["false"]-before["false"], 1.0; got != want {
+			t.Errorf("local %s for Record: got %v, want %v", codegen.MethodCountsName, got, want)
+		}
+		if got := after["true"] - before["true"]; got != 0 {
+			t.Errorf("remote %s for Record: got %v, want 0", codegen.MethodCountsName, got)
+		}
+
+		var kinds []protos.Span_Kind
+		for _, span := range tel.Spans() {
+			if span.Name == "simple.Destination.Record" {
+				kinds = append(kinds, span.Kind)
+			}
+		}
+		if want := []protos.Span_Kind{protos.Span_INTERNAL}; !reflect.DeepEqual(kinds, want) {
+			t.Errorf("span kinds for Record: got %v, want %v", kinds, want)
+		}
+	})
+}
+
 func BenchmarkCall(b *testing.B) {
 	for _, runner := range weavertest.AllRunners() {
 		runner.Bench(b, func(b *testing.B, dst simple.Destination) {
@@ -221,3 +477,26 @@ func BenchmarkTracedCall(b *testing.B) {
 		}
 	})
 }
+
+func TestSingleton(t *testing.T) {
+	for _, runner := range weavertest.AllRunners() {
+		runner.Test(t, func(t *testing.T, r simple.Reconciler) {
+			// Every call is handled by the leader.
+			ctx := context.Background()
+			pids := map[int]bool{}
+			for i := 0; i < 10; i++ {
+				pid, leader, err := r.Leader(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !leader {
+					t.Fatalf("call %d handled by non-leader %d", i, pid)
+				}
+				pids[pid] = true
+			}
+			if len(pids) != 1 {
+				t.Fatalf("calls handled by %d replicas, want 1", len(pids))
+			}
+		})
+	}
+}
@@ -15,11 +15,13 @@ import (
 
 func init() {
 	codegen.Register(codegen.Registration{
-		Name:    "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination",
-		Iface:   reflect.TypeOf((*Destination)(nil)).Elem(),
-		Impl:    reflect.TypeOf(destination{}),
-		Routed:  true,
-		NoRetry: []int{2, 3},
+		Name:       "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination",
+		Iface:      reflect.TypeOf((*Destination)(nil)).Elem(),
+		Impl:       reflect.TypeOf(destination{}),
+		Routed:     true,
+		RoutingKey: reflect.TypeOf((*string)(nil)).Elem(),
+		ShardKeyFn: func(key any) uint64 { return _hashDestination(key.(string)) },
+		NoRetry:    []int{2, 3},
 		LocalStubFn: func(impl any, caller string, tracer trace.Tracer) any {
 			return destination_local_stub{impl: impl.(Destination), tracer: tracer, getAllMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", Method: "GetAll", Remote: false}), getpidMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", Method: "Getpid", Remote: false}), recordMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", Method: "Record", Remote: false}), routedRecordMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination", Method: "RoutedRecord", Remote: false})}
 		},
@@ -32,7 +34,26 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return destination_reflect_stub{caller: caller}
 		},
-		RefData: "",
+		RefData: "⟦76d03fc8:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination→0c91d317;GetAll=0ff92a24,Getpid=ae27e0f8,Record=4e53eb56/noretry,RoutedRecord=4e53eb56/noretry⟧\n",
+	})
+	codegen.Register(codegen.Registration{
+		Name:      "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Reconciler",
+		Iface:     reflect.TypeOf((*Reconciler)(nil)).Elem(),
+		Impl:      reflect.TypeOf(reconciler{}),
+		Singleton: true,
+		LocalStubFn: func(impl any, caller string, tracer trace.Tracer) any {
+			return reconciler_local_stub{impl: impl.(Reconciler), tracer: tracer, leaderMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Reconciler", Method: "Leader", Remote: false})}
+		},
+		ClientStubFn: func(stub codegen.Stub, caller string) any {
+			return reconciler_client_stub{stub: stub, leaderMetrics: codegen.MethodMetricsFor(codegen.MethodLabels{Caller: caller, Component: "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Reconciler", Method: "Leader", Remote: true})}
+		},
+		ServerStubFn: func(impl any, addLoad func(uint64, float64)) codegen.Server {
+			return reconciler_server_stub{impl: impl.(Reconciler), addLoad: addLoad}
+		},
+		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
+			return reconciler_reflect_stub{caller: caller}
+		},
+		RefData: "⟦de85b6d2:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/weavertest/internal/simple/Reconciler→;Leader=fd622380⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:      "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server",
@@ -51,7 +72,7 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return server_reflect_stub{caller: caller}
 		},
-		RefData: "⟦1e2dce71:wEaVeRlIsTeNeRs:github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server→hello⟧\n",
+		RefData: "⟦1e2dce71:wEaVeRlIsTeNeRs:github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server→hello⟧\n⟦a6576126:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server→;Address=ad8020ef,ProxyAddress=ad8020ef,Shutdown=c5db102e⟧\n",
 	})
 	codegen.Register(codegen.Registration{
 		Name:    "github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source",
@@ -70,17 +91,19 @@ func init() {
 		ReflectStubFn: func(caller func(string, context.Context, []any, []any) error) any {
 			return source_reflect_stub{caller: caller}
 		},
-		RefData: "⟦bf914175:wEaVeReDgE:github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source→github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination⟧\n",
+		RefData: "⟦bf914175:wEaVeReDgE:github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source→github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination⟧\n⟦cd460dee:wEaVeRcOmPoNeNt:github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source→;Emit=4e53eb56/noretry⟧\n",
 	})
 }
 
 // weaver.InstanceOf checks.
 var _ weaver.InstanceOf[Destination] = (*destination)(nil)
+var _ weaver.InstanceOf[Reconciler] = (*reconciler)(nil)
 var _ weaver.InstanceOf[Server] = (*server)(nil)
 var _ weaver.InstanceOf[Source] = (*source)(nil)
 
 // weaver.Router checks.
 var _ weaver.RoutedBy[destRouter] = (*destination)(nil)
+var _ weaver.Unrouted = (*reconciler)(nil)
 var _ weaver.Unrouted = (*server)(nil)
 var _ weaver.Unrouted = (*source)(nil)
 
@@ -122,7 +145,7 @@ func (s destination_local_stub) GetAll(ctx context.Context, a0 string) (r0 []str
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "simple.Destination.GetAll", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "simple.Destination.GetAll", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -142,7 +165,7 @@ func (s destination_local_stub) Getpid(ctx context.Context) (r0 int, err error)
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "simple.Destination.Getpid", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "simple.Destination.Getpid", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -162,7 +185,7 @@ func (s destination_local_stub) Record(ctx context.Context, a0 string, a1 string
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "simple.Destination.Record", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "simple.Destination.Record", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -182,7 +205,7 @@ func (s destination_local_stub) RoutedRecord(ctx context.Context, a0 string, a1
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "simple.Destination.RoutedRecord", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "simple.Destination.RoutedRecord", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -195,6 +218,35 @@ func (s destination_local_stub) RoutedRecord(ctx context.Context, a0 string, a1
 	return s.impl.RoutedRecord(ctx, a0, a1)
 }
 
+type reconciler_local_stub struct {
+	impl          Reconciler
+	tracer        trace.Tracer
+	leaderMetrics *codegen.MethodMetrics
+}
+
+// Check that reconciler_local_stub implements the Reconciler interface.
+var _ Reconciler = (*reconciler_local_stub)(nil)
+
+func (s reconciler_local_stub) Leader(ctx context.Context) (r0 int, r1 bool, err error) {
+	// Update metrics.
+	begin := s.leaderMetrics.Begin()
+	defer func() { s.leaderMetrics.End(begin, err != nil, 0, 0) }()
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.tracer.Start(ctx, "simple.Reconciler.Leader", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Reconciler")))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+	}
+
+	return s.impl.Leader(ctx)
+}
+
 type server_local_stub struct {
 	impl                Server
 	tracer              trace.Tracer
@@ -213,7 +265,7 @@ func (s server_local_stub) Address(ctx context.Context) (r0 string, err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "simple.Server.Address", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "simple.Server.Address", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -233,7 +285,7 @@ func (s server_local_stub) ProxyAddress(ctx context.Context) (r0 string, err err
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "simple.Server.ProxyAddress", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "simple.Server.ProxyAddress", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -253,7 +305,7 @@ func (s server_local_stub) Shutdown(ctx context.Context) (err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "simple.Server.Shutdown", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "simple.Server.Shutdown", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -282,7 +334,7 @@ func (s source_local_stub) Emit(ctx context.Context, a0 string, a1 string) (err
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.tracer.Start(ctx, "simple.Source.Emit", trace.WithSpanKind(trace.SpanKindInternal))
+		ctx, span = s.tracer.Start(ctx, "simple.Source.Emit", trace.WithSpanKind(trace.SpanKindInternal), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source")))
 		defer func() {
 			if err != nil {
 				span.RecordError(err)
@@ -317,7 +369,7 @@ func (s destination_client_stub) GetAll(ctx context.Context, a0 string) (r0 []st
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "simple.Destination.GetAll", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "simple.Destination.GetAll", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination")))
 	}
 
 	defer func() {
@@ -373,7 +425,7 @@ func (s destination_client_stub) Getpid(ctx context.Context) (r0 int, err error)
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "simple.Destination.Getpid", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "simple.Destination.Getpid", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination")))
 	}
 
 	defer func() {
@@ -420,7 +472,7 @@ func (s destination_client_stub) Record(ctx context.Context, a0 string, a1 strin
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "simple.Destination.Record", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "simple.Destination.Record", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination")))
 	}
 
 	defer func() {
@@ -477,7 +529,7 @@ func (s destination_client_stub) RoutedRecord(ctx context.Context, a0 string, a1
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "simple.Destination.RoutedRecord", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "simple.Destination.RoutedRecord", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Destination")))
 	}
 
 	defer func() {
@@ -528,6 +580,62 @@ func (s destination_client_stub) RoutedRecord(ctx context.Context, a0 string, a1
 	return
 }
 
+type reconciler_client_stub struct {
+	stub          codegen.Stub
+	leaderMetrics *codegen.MethodMetrics
+}
+
+// Check that reconciler_client_stub implements the Reconciler interface.
+var _ Reconciler = (*reconciler_client_stub)(nil)
+
+func (s reconciler_client_stub) Leader(ctx context.Context) (r0 int, r1 bool, err error) {
+	// Update metrics.
+	var requestBytes, replyBytes int
+	begin := s.leaderMetrics.Begin()
+	defer func() { s.leaderMetrics.End(begin, err != nil, requestBytes, replyBytes) }()
+
+	span := trace.SpanFromContext(ctx)
+	if span.SpanContext().IsValid() {
+		// Create a child span for this method.
+		ctx, span = s.stub.Tracer().Start(ctx, "simple.Reconciler.Leader", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Reconciler")))
+	}
+
+	defer func() {
+		// Catch and return any panics detected during encoding/decoding/rpc.
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+			if err != nil {
+				err = errors.Join(weaver.RemoteCallError, err)
+			}
+		}
+
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+
+	}()
+
+	var shardKey uint64
+
+	// Call the remote method.
+	var results []byte
+	results, err = s.stub.Run(ctx, 0, nil, shardKey)
+	replyBytes = len(results)
+	if err != nil {
+		err = errors.Join(weaver.RemoteCallError, err)
+		return
+	}
+
+	// Decode the results.
+	dec := codegen.NewDecoder(results)
+	r0 = dec.Int()
+	r1 = dec.Bool()
+	err = dec.Error()
+	return
+}
+
 type server_client_stub struct {
 	stub                codegen.Stub
 	addressMetrics      *codegen.MethodMetrics
@@ -547,7 +655,7 @@ func (s server_client_stub) Address(ctx context.Context) (r0 string, err error)
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "simple.Server.Address", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "simple.Server.Address", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server")))
 	}
 
 	defer func() {
@@ -594,7 +702,7 @@ func (s server_client_stub) ProxyAddress(ctx context.Context) (r0 string, err er
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "simple.Server.ProxyAddress", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "simple.Server.ProxyAddress", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server")))
 	}
 
 	defer func() {
@@ -641,7 +749,7 @@ func (s server_client_stub) Shutdown(ctx context.Context) (err error) {
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "simple.Server.Shutdown", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "simple.Server.Shutdown", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Server")))
 	}
 
 	defer func() {
@@ -695,7 +803,7 @@ func (s source_client_stub) Emit(ctx context.Context, a0 string, a1 string) (err
 	span := trace.SpanFromContext(ctx)
 	if span.SpanContext().IsValid() {
 		// Create a child span for this method.
-		ctx, span = s.stub.Tracer().Start(ctx, "simple.Source.Emit", trace.WithSpanKind(trace.SpanKindClient))
+		ctx, span = s.stub.Tracer().Start(ctx, "simple.Source.Emit", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(codegen.ComponentTraceKey.String("github.com/ServiceWeaver/weaver/weavertest/internal/simple/Source")))
 	}
 
 	defer func() {
@@ -746,10 +854,10 @@ func (s source_client_stub) Emit(ctx context.Context, a0 string, a1 string) (err
 // Note that "weaver generate" will always generate the error message below.
 // Everything is okay. The error message is only relevant if you see it when
 // you run "go build" or "go run".
-var _ codegen.LatestVersion = codegen.Version[[0][20]struct{}](`
+var _ codegen.LatestVersion = codegen.Version[[0][23]struct{}](`
 
 ERROR: You generated this file with 'weaver generate' (devel) (codegen
-version v0.20.0). The generated code is incompatible with the version of the
+version v0.23.0). The generated code is incompatible with the version of the
 github.com/ServiceWeaver/weaver module that you're using. The weaver module
 version can be found in your go.mod file or by running the following command.
 
@@ -891,6 +999,45 @@ func (s destination_server_stub) routedRecord(ctx context.Context, args []byte)
 	return enc.Data(), nil
 }
 
+type reconciler_server_stub struct {
+	impl    Reconciler
+	addLoad func(key uint64, load float64)
+}
+
+// Check that reconciler_server_stub implements the codegen.Server interface.
+var _ codegen.Server = (*reconciler_server_stub)(nil)
+
+// GetStubFn implements the codegen.Server interface.
+func (s reconciler_server_stub) GetStubFn(method string) func(ctx context.Context, args []byte) ([]byte, error) {
+	switch method {
+	case "Leader":
+		return s.leader
+	default:
+		return nil
+	}
+}
+
+func (s reconciler_server_stub) leader(ctx context.Context, args []byte) (res []byte, err error) {
+	// Catch and return any panics detected during encoding/decoding/rpc.
+	defer func() {
+		if err == nil {
+			err = codegen.CatchPanics(recover())
+		}
+	}()
+
+	// TODO(rgrandl): The deferred function above will recover from panics in the
+	// user code: fix this.
+	// Call the local method.
+	r0, r1, appErr := s.impl.Leader(ctx)
+
+	// Encode the results.
+	enc := codegen.NewEncoder()
+	enc.Int(r0)
+	enc.Bool(r1)
+	enc.Error(appErr)
+	return enc.Data(), nil
+}
+
 type server_server_stub struct {
 	impl    Server
 	addLoad func(key uint64, load float64)
@@ -1045,6 +1192,18 @@ func (s destination_reflect_stub) RoutedRecord(ctx context.Context, a0 string, a
 	return
 }
 
+type reconciler_reflect_stub struct {
+	caller func(string, context.Context, []any, []any) error
+}
+
+// Check that reconciler_reflect_stub implements the Reconciler interface.
+var _ Reconciler = (*reconciler_reflect_stub)(nil)
+
+func (s reconciler_reflect_stub) Leader(ctx context.Context) (r0 int, r1 bool, err error) {
+	err = s.caller("Leader", ctx, []any{}, []any{&r0, &r1})
+	return
+}
+
 type server_reflect_stub struct {
 	caller func(string, context.Context, []any, []any) error
 }
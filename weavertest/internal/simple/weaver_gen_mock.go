@@ -0,0 +1,596 @@
+// Code generated by "weaver generate". DO NOT EDIT.
+//go:build !ignoreWeaverGen && !ignoreWeaverMocks
+
+package simple
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// MockDestination is a mock implementation of the Destination component interface.
+// Use the Expect and Stub methods to specify how calls are handled, and pass
+// the mock to weavertest.Fake to replace the component in a test.
+type MockDestination struct {
+	mu                   sync.Mutex
+	getAllExpected       []func(ctx context.Context, a0 string) (r0 []string, err error)
+	getAllStub           func(ctx context.Context, a0 string) (r0 []string, err error)
+	getAllCalls          []MockDestinationGetAllCall
+	getpidExpected       []func(ctx context.Context) (r0 int, err error)
+	getpidStub           func(ctx context.Context) (r0 int, err error)
+	getpidCalls          []MockDestinationGetpidCall
+	recordExpected       []func(ctx context.Context, a0 string, a1 string) (err error)
+	recordStub           func(ctx context.Context, a0 string, a1 string) (err error)
+	recordCalls          []MockDestinationRecordCall
+	routedRecordExpected []func(ctx context.Context, a0 string, a1 string) (err error)
+	routedRecordStub     func(ctx context.Context, a0 string, a1 string) (err error)
+	routedRecordCalls    []MockDestinationRoutedRecordCall
+	unexpected           []string
+}
+
+// Check that MockDestination implements the Destination interface.
+var _ Destination = (*MockDestination)(nil)
+
+// MockDestinationGetAllCall records the arguments of a call to Destination.GetAll.
+type MockDestinationGetAllCall struct {
+	A0 string
+}
+
+// ExpectGetAll arranges for the next call to GetAll that is not handled by an
+// earlier expectation to be handled by fn.
+func (m *MockDestination) ExpectGetAll(fn func(ctx context.Context, a0 string) (r0 []string, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getAllExpected = append(m.getAllExpected, fn)
+}
+
+// StubGetAll arranges for calls to GetAll without a pending expectation to be
+// handled by fn.
+func (m *MockDestination) StubGetAll(fn func(ctx context.Context, a0 string) (r0 []string, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getAllStub = fn
+}
+
+// GetAllCalls returns the calls made to GetAll so far, in order.
+func (m *MockDestination) GetAllCalls() []MockDestinationGetAllCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockDestinationGetAllCall(nil), m.getAllCalls...)
+}
+
+// GetAll implements the Destination interface.
+func (m *MockDestination) GetAll(ctx context.Context, a0 string) (r0 []string, err error) {
+	m.mu.Lock()
+	m.getAllCalls = append(m.getAllCalls, MockDestinationGetAllCall{A0: a0})
+	fn := m.getAllStub
+	if len(m.getAllExpected) > 0 {
+		fn = m.getAllExpected[0]
+		m.getAllExpected = m.getAllExpected[1:]
+	}
+	if fn == nil {
+		m.unexpected = append(m.unexpected, "GetAll")
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		err = errors.New("MockDestination: unexpected call to GetAll")
+		return
+	}
+	return fn(ctx, a0)
+}
+
+// MockDestinationGetpidCall records the arguments of a call to Destination.Getpid.
+type MockDestinationGetpidCall struct {
+}
+
+// ExpectGetpid arranges for the next call to Getpid that is not handled by an
+// earlier expectation to be handled by fn.
+func (m *MockDestination) ExpectGetpid(fn func(ctx context.Context) (r0 int, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getpidExpected = append(m.getpidExpected, fn)
+}
+
+// StubGetpid arranges for calls to Getpid without a pending expectation to be
+// handled by fn.
+func (m *MockDestination) StubGetpid(fn func(ctx context.Context) (r0 int, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getpidStub = fn
+}
+
+// GetpidCalls returns the calls made to Getpid so far, in order.
+func (m *MockDestination) GetpidCalls() []MockDestinationGetpidCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockDestinationGetpidCall(nil), m.getpidCalls...)
+}
+
+// Getpid implements the Destination interface.
+func (m *MockDestination) Getpid(ctx context.Context) (r0 int, err error) {
+	m.mu.Lock()
+	m.getpidCalls = append(m.getpidCalls, MockDestinationGetpidCall{})
+	fn := m.getpidStub
+	if len(m.getpidExpected) > 0 {
+		fn = m.getpidExpected[0]
+		m.getpidExpected = m.getpidExpected[1:]
+	}
+	if fn == nil {
+		m.unexpected = append(m.unexpected, "Getpid")
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		err = errors.New("MockDestination: unexpected call to Getpid")
+		return
+	}
+	return fn(ctx)
+}
+
+// MockDestinationRecordCall records the arguments of a call to Destination.Record.
+type MockDestinationRecordCall struct {
+	A0 string
+	A1 string
+}
+
+// ExpectRecord arranges for the next call to Record that is not handled by an
+// earlier expectation to be handled by fn.
+func (m *MockDestination) ExpectRecord(fn func(ctx context.Context, a0 string, a1 string) (err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.recordExpected = append(m.recordExpected, fn)
+}
+
+// StubRecord arranges for calls to Record without a pending expectation to be
+// handled by fn.
+func (m *MockDestination) StubRecord(fn func(ctx context.Context, a0 string, a1 string) (err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.recordStub = fn
+}
+
+// RecordCalls returns the calls made to Record so far, in order.
+func (m *MockDestination) RecordCalls() []MockDestinationRecordCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockDestinationRecordCall(nil), m.recordCalls...)
+}
+
+// Record implements the Destination interface.
+func (m *MockDestination) Record(ctx context.Context, a0 string, a1 string) (err error) {
+	m.mu.Lock()
+	m.recordCalls = append(m.recordCalls, MockDestinationRecordCall{A0: a0, A1: a1})
+	fn := m.recordStub
+	if len(m.recordExpected) > 0 {
+		fn = m.recordExpected[0]
+		m.recordExpected = m.recordExpected[1:]
+	}
+	if fn == nil {
+		m.unexpected = append(m.unexpected, "Record")
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		err = errors.New("MockDestination: unexpected call to Record")
+		return
+	}
+	return fn(ctx, a0, a1)
+}
+
+// MockDestinationRoutedRecordCall records the arguments of a call to Destination.RoutedRecord.
+type MockDestinationRoutedRecordCall struct {
+	A0 string
+	A1 string
+}
+
+// ExpectRoutedRecord arranges for the next call to RoutedRecord that is not handled by an
+// earlier expectation to be handled by fn.
+func (m *MockDestination) ExpectRoutedRecord(fn func(ctx context.Context, a0 string, a1 string) (err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.routedRecordExpected = append(m.routedRecordExpected, fn)
+}
+
+// StubRoutedRecord arranges for calls to RoutedRecord without a pending expectation to be
+// handled by fn.
+func (m *MockDestination) StubRoutedRecord(fn func(ctx context.Context, a0 string, a1 string) (err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.routedRecordStub = fn
+}
+
+// RoutedRecordCalls returns the calls made to RoutedRecord so far, in order.
+func (m *MockDestination) RoutedRecordCalls() []MockDestinationRoutedRecordCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockDestinationRoutedRecordCall(nil), m.routedRecordCalls...)
+}
+
+// RoutedRecord implements the Destination interface.
+func (m *MockDestination) RoutedRecord(ctx context.Context, a0 string, a1 string) (err error) {
+	m.mu.Lock()
+	m.routedRecordCalls = append(m.routedRecordCalls, MockDestinationRoutedRecordCall{A0: a0, A1: a1})
+	fn := m.routedRecordStub
+	if len(m.routedRecordExpected) > 0 {
+		fn = m.routedRecordExpected[0]
+		m.routedRecordExpected = m.routedRecordExpected[1:]
+	}
+	if fn == nil {
+		m.unexpected = append(m.unexpected, "RoutedRecord")
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		err = errors.New("MockDestination: unexpected call to RoutedRecord")
+		return
+	}
+	return fn(ctx, a0, a1)
+}
+
+// Verify returns an error if an expected call was not made, or if a call
+// was made that was neither expected nor stubbed.
+func (m *MockDestination) Verify() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var errs []error
+	if n := len(m.getAllExpected); n > 0 {
+		errs = append(errs, fmt.Errorf("MockDestination: %d expected call(s) to GetAll not made", n))
+	}
+	if n := len(m.getpidExpected); n > 0 {
+		errs = append(errs, fmt.Errorf("MockDestination: %d expected call(s) to Getpid not made", n))
+	}
+	if n := len(m.recordExpected); n > 0 {
+		errs = append(errs, fmt.Errorf("MockDestination: %d expected call(s) to Record not made", n))
+	}
+	if n := len(m.routedRecordExpected); n > 0 {
+		errs = append(errs, fmt.Errorf("MockDestination: %d expected call(s) to RoutedRecord not made", n))
+	}
+	for _, method := range m.unexpected {
+		errs = append(errs, fmt.Errorf("MockDestination: unexpected call to %s", method))
+	}
+	return errors.Join(errs...)
+}
+
+// MockReconciler is a mock implementation of the Reconciler component interface.
+// Use the Expect and Stub methods to specify how calls are handled, and pass
+// the mock to weavertest.Fake to replace the component in a test.
+type MockReconciler struct {
+	mu             sync.Mutex
+	leaderExpected []func(ctx context.Context) (r0 int, r1 bool, err error)
+	leaderStub     func(ctx context.Context) (r0 int, r1 bool, err error)
+	leaderCalls    []MockReconcilerLeaderCall
+	unexpected     []string
+}
+
+// Check that MockReconciler implements the Reconciler interface.
+var _ Reconciler = (*MockReconciler)(nil)
+
+// MockReconcilerLeaderCall records the arguments of a call to Reconciler.Leader.
+type MockReconcilerLeaderCall struct {
+}
+
+// ExpectLeader arranges for the next call to Leader that is not handled by an
+// earlier expectation to be handled by fn.
+func (m *MockReconciler) ExpectLeader(fn func(ctx context.Context) (r0 int, r1 bool, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.leaderExpected = append(m.leaderExpected, fn)
+}
+
+// StubLeader arranges for calls to Leader without a pending expectation to be
+// handled by fn.
+func (m *MockReconciler) StubLeader(fn func(ctx context.Context) (r0 int, r1 bool, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.leaderStub = fn
+}
+
+// LeaderCalls returns the calls made to Leader so far, in order.
+func (m *MockReconciler) LeaderCalls() []MockReconcilerLeaderCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockReconcilerLeaderCall(nil), m.leaderCalls...)
+}
+
+// Leader implements the Reconciler interface.
+func (m *MockReconciler) Leader(ctx context.Context) (r0 int, r1 bool, err error) {
+	m.mu.Lock()
+	m.leaderCalls = append(m.leaderCalls, MockReconcilerLeaderCall{})
+	fn := m.leaderStub
+	if len(m.leaderExpected) > 0 {
+		fn = m.leaderExpected[0]
+		m.leaderExpected = m.leaderExpected[1:]
+	}
+	if fn == nil {
+		m.unexpected = append(m.unexpected, "Leader")
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		err = errors.New("MockReconciler: unexpected call to Leader")
+		return
+	}
+	return fn(ctx)
+}
+
+// Verify returns an error if an expected call was not made, or if a call
+// was made that was neither expected nor stubbed.
+func (m *MockReconciler) Verify() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var errs []error
+	if n := len(m.leaderExpected); n > 0 {
+		errs = append(errs, fmt.Errorf("MockReconciler: %d expected call(s) to Leader not made", n))
+	}
+	for _, method := range m.unexpected {
+		errs = append(errs, fmt.Errorf("MockReconciler: unexpected call to %s", method))
+	}
+	return errors.Join(errs...)
+}
+
+// MockServer is a mock implementation of the Server component interface.
+// Use the Expect and Stub methods to specify how calls are handled, and pass
+// the mock to weavertest.Fake to replace the component in a test.
+type MockServer struct {
+	mu                   sync.Mutex
+	addressExpected      []func(ctx context.Context) (r0 string, err error)
+	addressStub          func(ctx context.Context) (r0 string, err error)
+	addressCalls         []MockServerAddressCall
+	proxyAddressExpected []func(ctx context.Context) (r0 string, err error)
+	proxyAddressStub     func(ctx context.Context) (r0 string, err error)
+	proxyAddressCalls    []MockServerProxyAddressCall
+	shutdownExpected     []func(ctx context.Context) (err error)
+	shutdownStub         func(ctx context.Context) (err error)
+	shutdownCalls        []MockServerShutdownCall
+	unexpected           []string
+}
+
+// Check that MockServer implements the Server interface.
+var _ Server = (*MockServer)(nil)
+
+// MockServerAddressCall records the arguments of a call to Server.Address.
+type MockServerAddressCall struct {
+}
+
+// ExpectAddress arranges for the next call to Address that is not handled by an
+// earlier expectation to be handled by fn.
+func (m *MockServer) ExpectAddress(fn func(ctx context.Context) (r0 string, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.addressExpected = append(m.addressExpected, fn)
+}
+
+// StubAddress arranges for calls to Address without a pending expectation to be
+// handled by fn.
+func (m *MockServer) StubAddress(fn func(ctx context.Context) (r0 string, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.addressStub = fn
+}
+
+// AddressCalls returns the calls made to Address so far, in order.
+func (m *MockServer) AddressCalls() []MockServerAddressCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockServerAddressCall(nil), m.addressCalls...)
+}
+
+// Address implements the Server interface.
+func (m *MockServer) Address(ctx context.Context) (r0 string, err error) {
+	m.mu.Lock()
+	m.addressCalls = append(m.addressCalls, MockServerAddressCall{})
+	fn := m.addressStub
+	if len(m.addressExpected) > 0 {
+		fn = m.addressExpected[0]
+		m.addressExpected = m.addressExpected[1:]
+	}
+	if fn == nil {
+		m.unexpected = append(m.unexpected, "Address")
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		err = errors.New("MockServer: unexpected call to Address")
+		return
+	}
+	return fn(ctx)
+}
+
+// MockServerProxyAddressCall records the arguments of a call to Server.ProxyAddress.
+type MockServerProxyAddressCall struct {
+}
+
+// ExpectProxyAddress arranges for the next call to ProxyAddress that is not handled by an
+// earlier expectation to be handled by fn.
+func (m *MockServer) ExpectProxyAddress(fn func(ctx context.Context) (r0 string, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.proxyAddressExpected = append(m.proxyAddressExpected, fn)
+}
+
+// StubProxyAddress arranges for calls to ProxyAddress without a pending expectation to be
+// handled by fn.
+func (m *MockServer) StubProxyAddress(fn func(ctx context.Context) (r0 string, err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.proxyAddressStub = fn
+}
+
+// ProxyAddressCalls returns the calls made to ProxyAddress so far, in order.
+func (m *MockServer) ProxyAddressCalls() []MockServerProxyAddressCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockServerProxyAddressCall(nil), m.proxyAddressCalls...)
+}
+
+// ProxyAddress implements the Server interface.
+func (m *MockServer) ProxyAddress(ctx context.Context) (r0 string, err error) {
+	m.mu.Lock()
+	m.proxyAddressCalls = append(m.proxyAddressCalls, MockServerProxyAddressCall{})
+	fn := m.proxyAddressStub
+	if len(m.proxyAddressExpected) > 0 {
+		fn = m.proxyAddressExpected[0]
+		m.proxyAddressExpected = m.proxyAddressExpected[1:]
+	}
+	if fn == nil {
+		m.unexpected = append(m.unexpected, "ProxyAddress")
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		err = errors.New("MockServer: unexpected call to ProxyAddress")
+		return
+	}
+	return fn(ctx)
+}
+
+// MockServerShutdownCall records the arguments of a call to Server.Shutdown.
+type MockServerShutdownCall struct {
+}
+
+// ExpectShutdown arranges for the next call to Shutdown that is not handled by an
+// earlier expectation to be handled by fn.
+func (m *MockServer) ExpectShutdown(fn func(ctx context.Context) (err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.shutdownExpected = append(m.shutdownExpected, fn)
+}
+
+// StubShutdown arranges for calls to Shutdown without a pending expectation to be
+// handled by fn.
+func (m *MockServer) StubShutdown(fn func(ctx context.Context) (err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.shutdownStub = fn
+}
+
+// ShutdownCalls returns the calls made to Shutdown so far, in order.
+func (m *MockServer) ShutdownCalls() []MockServerShutdownCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockServerShutdownCall(nil), m.shutdownCalls...)
+}
+
+// Shutdown implements the Server interface.
+func (m *MockServer) Shutdown(ctx context.Context) (err error) {
+	m.mu.Lock()
+	m.shutdownCalls = append(m.shutdownCalls, MockServerShutdownCall{})
+	fn := m.shutdownStub
+	if len(m.shutdownExpected) > 0 {
+		fn = m.shutdownExpected[0]
+		m.shutdownExpected = m.shutdownExpected[1:]
+	}
+	if fn == nil {
+		m.unexpected = append(m.unexpected, "Shutdown")
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		err = errors.New("MockServer: unexpected call to Shutdown")
+		return
+	}
+	return fn(ctx)
+}
+
+// Verify returns an error if an expected call was not made, or if a call
+// was made that was neither expected nor stubbed.
+func (m *MockServer) Verify() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var errs []error
+	if n := len(m.addressExpected); n > 0 {
+		errs = append(errs, fmt.Errorf("MockServer: %d expected call(s) to Address not made", n))
+	}
+	if n := len(m.proxyAddressExpected); n > 0 {
+		errs = append(errs, fmt.Errorf("MockServer: %d expected call(s) to ProxyAddress not made", n))
+	}
+	if n := len(m.shutdownExpected); n > 0 {
+		errs = append(errs, fmt.Errorf("MockServer: %d expected call(s) to Shutdown not made", n))
+	}
+	for _, method := range m.unexpected {
+		errs = append(errs, fmt.Errorf("MockServer: unexpected call to %s", method))
+	}
+	return errors.Join(errs...)
+}
+
+// MockSource is a mock implementation of the Source component interface.
+// Use the Expect and Stub methods to specify how calls are handled, and pass
+// the mock to weavertest.Fake to replace the component in a test.
+type MockSource struct {
+	mu           sync.Mutex
+	emitExpected []func(ctx context.Context, a0 string, a1 string) (err error)
+	emitStub     func(ctx context.Context, a0 string, a1 string) (err error)
+	emitCalls    []MockSourceEmitCall
+	unexpected   []string
+}
+
+// Check that MockSource implements the Source interface.
+var _ Source = (*MockSource)(nil)
+
+// MockSourceEmitCall records the arguments of a call to Source.Emit.
+type MockSourceEmitCall struct {
+	A0 string
+	A1 string
+}
+
+// ExpectEmit arranges for the next call to Emit that is not handled by an
+// earlier expectation to be handled by fn.
+func (m *MockSource) ExpectEmit(fn func(ctx context.Context, a0 string, a1 string) (err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.emitExpected = append(m.emitExpected, fn)
+}
+
+// StubEmit arranges for calls to Emit without a pending expectation to be
+// handled by fn.
+func (m *MockSource) StubEmit(fn func(ctx context.Context, a0 string, a1 string) (err error)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.emitStub = fn
+}
+
+// EmitCalls returns the calls made to Emit so far, in order.
+func (m *MockSource) EmitCalls() []MockSourceEmitCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]MockSourceEmitCall(nil), m.emitCalls...)
+}
+
+// Emit implements the Source interface.
+func (m *MockSource) Emit(ctx context.Context, a0 string, a1 string) (err error) {
+	m.mu.Lock()
+	m.emitCalls = append(m.emitCalls, MockSourceEmitCall{A0: a0, A1: a1})
+	fn := m.emitStub
+	if len(m.emitExpected) > 0 {
+		fn = m.emitExpected[0]
+		m.emitExpected = m.emitExpected[1:]
+	}
+	if fn == nil {
+		m.unexpected = append(m.unexpected, "Emit")
+	}
+	m.mu.Unlock()
+
+	if fn == nil {
+		err = errors.New("MockSource: unexpected call to Emit")
+		return
+	}
+	return fn(ctx, a0, a1)
+}
+
+// Verify returns an error if an expected call was not made, or if a call
+// was made that was neither expected nor stubbed.
+func (m *MockSource) Verify() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var errs []error
+	if n := len(m.emitExpected); n > 0 {
+		errs = append(errs, fmt.Errorf("MockSource: %d expected call(s) to Emit not made", n))
+	}
+	for _, method := range m.unexpected {
+		errs = append(errs, fmt.Errorf("MockSource: unexpected call to %s", method))
+	}
+	return errors.Join(errs...)
+}
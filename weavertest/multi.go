@@ -39,10 +39,12 @@ const matchNothingRE = "a^" // Regular expression that never matches
 // locals contains components that should be co-located with the main component
 // and not replicated.
 //
-// logWriter is used to handle log entries generated by the execution.
+// logWriter is used to handle log entries generated by the execution, and tel
+// receives the execution's traces and metrics. Spans are exported as soon as
+// they end only if captureSpans is true.
 //
 // Future extension: allow options so the user can control collocation/replication/etc.
-func initMultiProcess(ctx context.Context, t testing.TB, isBench bool, runner Runner, locals []reflect.Type, logWriter func(*protos.LogEntry)) (runtime.Bootstrap, func() error, error) {
+func initMultiProcess(ctx context.Context, t testing.TB, isBench, captureSpans bool, runner Runner, locals []reflect.Type, logWriter func(*protos.LogEntry), tel *Telemetry) (runtime.Bootstrap, func() error, error) {
 	t.Helper()
 	bootstrap, err := runtime.GetBootstrap(ctx)
 	if err != nil {
@@ -59,7 +61,8 @@ func initMultiProcess(ctx context.Context, t testing.TB, isBench bool, runner Ru
 			os.Exit(1)
 		}()
 
-		opts := weaver.RemoteWeaveletOptions{}
+		// Faults are injected only by the test's process. See InjectFaults.
+		opts := weaver.RemoteWeaveletOptions{SyncTraces: captureSpans}
 		wlet, err := weaver.NewRemoteWeavelet(ctx, codegen.Registered(), bootstrap, opts)
 		if err != nil {
 			panic(err)
@@ -98,7 +101,7 @@ func initMultiProcess(ctx context.Context, t testing.TB, isBench bool, runner Ru
 	}
 
 	// Launch the deployer.
-	d := newDeployer(ctx, wlet, appConfig, runner, locals, logWriter)
+	d := newDeployer(ctx, wlet, appConfig, runner, locals, logWriter, tel)
 	bootstrap, err = d.start()
 	if err != nil {
 		return runtime.Bootstrap{}, nil, err
@@ -0,0 +1,197 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weavertest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ServiceWeaver/weaver/internal/callers"
+	"github.com/ServiceWeaver/weaver/internal/reflection"
+	"github.com/ServiceWeaver/weaver/internal/weaver"
+	"github.com/ServiceWeaver/weaver/runtime/codegen"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Replay replays the calls to component T recorded in the provided file
+// against component, and reports an error for every call whose results differ
+// from the recorded results. Replay is typically used to write regression
+// tests from recorded traffic. For example:
+//
+//	weavertest.Local.Test(t, func(t *testing.T, reader BalanceReader) {
+//	    weavertest.Replay(t, "testdata/balancereader.calls", reader)
+//	})
+//
+// Calls can be recorded in a test by setting Runner.RecordCalls, or in a
+// deployed application by setting the SERVICEWEAVER_RECORD_CALLS environment
+// variable to the name of a directory. Every process of the application then
+// writes the remote calls it makes to a new file in the directory.
+//
+// Recorded and replayed results are compared after they have been encoded and
+// decoded, as they would be for a remote call. Errors are compared by their
+// messages. Calls are replayed sequentially, in the order they were recorded.
+func Replay[T any](t testing.TB, filename string, component T) {
+	t.Helper()
+	intf := reflection.Type[T]()
+	var reg *codegen.Registration
+	for _, r := range codegen.Registered() {
+		if r.Iface == intf {
+			reg = r
+			break
+		}
+	}
+	if reg == nil {
+		t.Fatalf("Replay: component %v not found", intf)
+	}
+	if reg.ReflectStubFn == nil {
+		t.Fatalf("Replay: component %v has no reflect stub; re-run weaver generate", intf)
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	defer f.Close()
+	records, err := weaver.ReadCallRecords(f)
+	if err != nil {
+		t.Fatalf("Replay: %s: %v", filename, err)
+	}
+
+	ctx := context.Background()
+	n := 0
+	for i, record := range records {
+		if record.Component != reg.Name {
+			continue
+		}
+		n++
+		if err := replayCall(ctx, reg, component, record); err != nil {
+			t.Errorf("Replay: call %d: %v", i, err)
+		}
+	}
+	if n == 0 {
+		t.Fatalf("Replay: no calls to %s in %s", reg.Name, filename)
+	}
+}
+
+// replayCall replays a recorded call against component and returns an error
+// if the call fails or its results differ from the recorded results.
+func replayCall(ctx context.Context, reg *codegen.Registration, component any, record weaver.CallRecord) error {
+	if _, ok := reg.Iface.MethodByName(record.Method); !ok {
+		return fmt.Errorf("%s has no method %q", reg.Name, record.Method)
+	}
+
+	// Pass the recorded arguments to a server stub, so that they are decoded
+	// and the results are encoded exactly as they would be by a remote
+	// component. The server stub calls proxy, which calls component.
+	var args []any
+	proxy := reg.ReflectStubFn(func(method string, ctx context.Context, in []any, returns []any) error {
+		args = in
+		outs := invoke(ctx, component, method, in)
+		for i, r := range returns {
+			reflect.ValueOf(r).Elem().Set(outs[i])
+		}
+		err, _ := outs[len(outs)-1].Interface().(error)
+		return err
+	})
+	server := reg.ServerStubFn(proxy, func(uint64, float64) {})
+	results, err := server.GetStubFn(record.Method)(ctx, record.Args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", record.Method, err)
+	}
+
+	want, wantErr := decodeResults(ctx, reg, record.Method, args, record.Results)
+	got, gotErr := decodeResults(ctx, reg, record.Method, args, results)
+	if reflect.DeepEqual(got, want) && errorString(gotErr) == errorString(wantErr) {
+		return nil
+	}
+	return fmt.Errorf("%s(%s): got (%s), want (%s)", record.Method,
+		formatValues(args, nil), formatValues(got, gotErr), formatValues(want, wantErr))
+}
+
+// decodeResults decodes the results of a call to the provided method, encoded
+// by a server stub. It returns the non-error results and the error. If the
+// results cannot be decoded, the error is a weaver.RemoteCallError.
+func decodeResults(ctx context.Context, reg *codegen.Registration, method string, args []any, results []byte) ([]any, error) {
+	// Call the method on a client stub that returns the provided results. The
+	// client stub is uninstrumented, so the call isn't recorded in method
+	// metrics.
+	client := reg.ClientStubFn(replayStub{results}, callers.Uninstrumented)
+	outs := invoke(ctx, client, method, args)
+	values := make([]any, len(outs)-1)
+	for i := range values {
+		values[i] = outs[i].Interface()
+	}
+	err, _ := outs[len(outs)-1].Interface().(error)
+	return values, err
+}
+
+// invoke invokes the provided method on x with the provided arguments
+// (excluding the context) and returns the results.
+func invoke(ctx context.Context, x any, method string, args []any) []reflect.Value {
+	m := reflect.ValueOf(x).MethodByName(method)
+	in := []reflect.Value{reflect.ValueOf(ctx)}
+	for i, arg := range args {
+		v := reflect.ValueOf(arg)
+		if !v.IsValid() {
+			v = reflect.Zero(m.Type().In(i + 1))
+		}
+		in = append(in, v)
+	}
+	if m.Type().IsVariadic() {
+		return m.CallSlice(in)
+	}
+	return m.Call(in)
+}
+
+// formatValues formats a list of values and an optional error.
+func formatValues(values []any, err error) string {
+	var parts []string
+	for _, v := range values {
+		parts = append(parts, fmt.Sprintf("%#v", v))
+	}
+	if err != nil {
+		parts = append(parts, fmt.Sprintf("error %q", err.Error()))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// errorString returns err.Error(), or the empty string if err is nil.
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// replayStub is a codegen.Stub that returns fixed results for every call.
+type replayStub struct {
+	results []byte
+}
+
+var _ codegen.Stub = replayStub{}
+
+// Tracer implements the codegen.Stub interface.
+func (replayStub) Tracer() trace.Tracer {
+	return trace.NewNoopTracerProvider().Tracer("")
+}
+
+// Run implements the codegen.Stub interface.
+func (s replayStub) Run(context.Context, int, []byte, uint64) ([]byte, error) {
+	return s.results, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package weavertest
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/ServiceWeaver/weaver/runtime/metrics"
+	"github.com/ServiceWeaver/weaver/runtime/protos"
+)
+
+// Telemetry provides access to the logs, traces, and metrics produced by the
+// application under test. To receive a Telemetry, declare a *Telemetry
+// argument in the body passed to Runner.Test or Runner.Bench:
+//
+//	weavertest.Local.Test(t, func(t *testing.T, tel *weavertest.Telemetry, foo Foo) {
+//	    foo.Bar(ctx)
+//	    for _, entry := range tel.Logs() {
+//	        // ...
+//	    }
+//	})
+//
+// A Telemetry only includes logs and spans produced during the test that it
+// was passed to. Metrics are global to the test process: a Telemetry excludes
+// values recorded before the test started, but includes values recorded by
+// other tests running in parallel.
+type Telemetry struct {
+	baseline map[uint64]*metrics.MetricSnapshot        // metrics when the test started
+	remote   func() ([]*metrics.MetricSnapshot, error) // metrics of subprocesses, if any
+
+	mu    sync.Mutex         // guards the following fields
+	logs  []*protos.LogEntry // captured log entries
+	spans []*protos.Span     // captured spans
+}
+
+// telemetryType is the type of a *Telemetry.
+var telemetryType = reflect.TypeOf(&Telemetry{})
+
+// newTelemetry returns a new Telemetry that ignores the metrics recorded
+// before it was created.
+func newTelemetry() *Telemetry {
+	baseline := map[uint64]*metrics.MetricSnapshot{}
+	for _, m := range metrics.Snapshot() {
+		baseline[m.Id] = m
+	}
+	return &Telemetry{baseline: baseline}
+}
+
+// Logs returns the log entries produced so far, in the order they were
+// received. Logs includes entries written by the system as well as by
+// components. Filter by LogEntry.Component to find a component's logs.
+func (t *Telemetry) Logs() []*protos.LogEntry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.logs)
+}
+
+// Spans returns the spans completed so far. Note that component method calls
+// produce spans only when they are invoked with a context that already
+// contains a span.
+func (t *Telemetry) Spans() []*protos.Span {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.spans)
+}
+
+// Metrics returns a snapshot of every metric, including the automatically
+// populated serviceweaver_method_* metrics. Counters and histograms only
+// include values recorded during the test. When components run in multiple
+// processes, every process reports its own snapshots, so the same metric may
+// appear more than once.
+func (t *Telemetry) Metrics() ([]*metrics.MetricSnapshot, error) {
+	var snapshots []*metrics.MetricSnapshot
+	for _, m := range metrics.Snapshot() {
+		snapshots = append(snapshots, subtract(m, t.baseline[m.Id]))
+	}
+	if t.remote != nil {
+		remote, err := t.remote()
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, remote...)
+	}
+	return snapshots, nil
+}
+
+// addLog records a log entry.
+func (t *Telemetry) addLog(entry *protos.LogEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.logs = append(t.logs, entry)
+}
+
+// addSpans records a batch of spans.
+func (t *Telemetry) addSpans(spans *protos.TraceSpans) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans = append(t.spans, spans.Span...)
+	return nil
+}
+
+// subtract returns the value of a counter or histogram metric m minus its
+// value in base. Gauges are returned unchanged.
+func subtract(m, base *metrics.MetricSnapshot) *metrics.MetricSnapshot {
+	if base == nil || m.Type == protos.MetricType_GAUGE {
+		return m
+	}
+	m = m.Clone()
+	m.Value -= base.Value
+	for i := range m.Counts {
+		if i < len(base.Counts) {
+			m.Counts[i] -= base.Counts[i]
+		}
+	}
+	return m
+}